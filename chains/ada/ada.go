@@ -0,0 +1,122 @@
+package ada
+
+import (
+	"math/big"
+
+	"github.com/airsigner/libcrypto/types"
+	"github.com/airsigner/libcrypto/types/pb"
+	"github.com/shopspring/decimal"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+type adaDefinition struct{}
+
+func (adaDefinition) CoinName() string { return "ADA" }
+func (adaDefinition) UnitExp() int32   { return 6 }
+
+// Denominations returns lovelace and ADA, see types.CoinValue.Humanize.
+func (adaDefinition) Denominations() []types.Denomination {
+	return []types.Denomination{
+		{Name: "lovelace", Exp: 0},
+		{Name: "ADA", Exp: 6},
+	}
+}
+
+// Denominations returns the denominations of ADA, from the smallest to the largest, see types.CoinValue.In.
+func Denominations() []types.Denomination {
+	return adaDefinition{}.Denominations()
+}
+
+func init() {
+	types.Register(adaDefinition{}.CoinName(), func(lovelace *big.Int) types.Value {
+		return NewAdaFromLovelace(lovelace)
+	})
+}
+
+var _ types.Value = (*Ada)(nil)
+
+type Ada struct {
+	*types.CoinValue[adaDefinition]
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, see types.CoinValue.UnmarshalBinary.
+func (a *Ada) UnmarshalBinary(data []byte) error {
+	return types.DecodeInto(&a.CoinValue, func(v *types.CoinValue[adaDefinition]) error {
+		return v.UnmarshalBinary(data)
+	})
+}
+
+// FromProto sets the value to the value of its protobuf representation, see types.CoinValue.FromProto.
+func (a *Ada) FromProto(m *pb.CoinValue) error {
+	return types.DecodeInto(&a.CoinValue, func(v *types.CoinValue[adaDefinition]) error {
+		return v.FromProto(m)
+	})
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, see types.CoinValue.UnmarshalCBOR.
+func (a *Ada) UnmarshalCBOR(data []byte) error {
+	return types.DecodeInto(&a.CoinValue, func(v *types.CoinValue[adaDefinition]) error {
+		return v.UnmarshalCBOR(data)
+	})
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler, see types.CoinValue.UnmarshalBSONValue.
+func (a *Ada) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	return types.DecodeInto(&a.CoinValue, func(v *types.CoinValue[adaDefinition]) error {
+		return v.UnmarshalBSONValue(t, data)
+	})
+}
+
+func NewAda(ada decimal.Decimal) *Ada {
+	return &Ada{
+		types.NewCoinValueFromCoins[adaDefinition](ada),
+	}
+}
+
+// NewAdaExact is like NewAda but fails instead of truncating an amount more precise than the smallest unit.
+func NewAdaExact(ada decimal.Decimal) (*Ada, error) {
+	cv, err := types.NewCoinValueFromCoinsChecked[adaDefinition](ada)
+	if err != nil {
+		return nil, err
+	}
+	return &Ada{cv}, nil
+}
+
+// NewAdaFromString parses a decimal string amount of ADA, e.g. "1.5".
+func NewAdaFromString(s string) (*Ada, error) {
+	cv, err := types.ParseCoinValue[adaDefinition](s)
+	if err != nil {
+		return nil, err
+	}
+	return &Ada{cv}, nil
+}
+
+// MustNewAda is like NewAdaFromString but panics if s can't be parsed, intended for tests and constants.
+func MustNewAda(s string) *Ada {
+	return &Ada{types.MustParseCoinValue[adaDefinition](s)}
+}
+
+// NewAdaFromValue creates a Ada from a Value of the same coin, e.g. the result of Ada.Add.
+func NewAdaFromValue(v types.Value) (*Ada, error) {
+	cv, err := types.NewCoinValueFromValue[adaDefinition](v)
+	if err != nil {
+		return nil, err
+	}
+	return &Ada{cv}, nil
+}
+
+func NewAdaFromLovelace(lovelace *big.Int) *Ada {
+	return &Ada{
+		types.NewCoinValue[adaDefinition](lovelace),
+	}
+}
+
+// Lovelace returns the value of the Ada type in Lovelace.
+func (a Ada) Lovelace() *big.Int {
+	return a.Units()
+}
+
+// Ada returns the value of the Ada type in Ada.
+func (a Ada) Ada() decimal.Decimal {
+	return a.Coins()
+}
@@ -0,0 +1,165 @@
+package ada
+
+import (
+	"encoding/binary"
+	"hash/crc32"
+
+	"github.com/airsigner/libcrypto/internal/base58"
+	"github.com/airsigner/libcrypto/internal/bech32"
+)
+
+const (
+	networkTestnet = 0
+	networkMainnet = 1
+
+	// Shelley address header types, see CIP-19
+	headerBaseMax       = 3
+	headerPointerMax    = 5
+	headerEnterpriseMax = 7
+	headerStakeMin      = 14
+	headerStakeMax      = 15
+
+	hashLen = 28
+)
+
+// IsValidAddress checks if the address is a valid Cardano address.
+//
+// Both Shelley bech32 addresses (payment and stake) and legacy Byron base58 addresses are accepted.
+// For Shelley addresses the human readable part must match the network tag in the address header.
+func IsValidAddress(address string) bool {
+	if _, ok := decodeShelley(address); ok {
+		return true
+	}
+	return isValidByron(address)
+}
+
+// IsPaymentAddress checks if the address is a valid address funds can be sent to.
+//
+// This includes Shelley base, pointer and enterprise addresses as well as Byron addresses.
+func IsPaymentAddress(address string) bool {
+	if header, ok := decodeShelley(address); ok {
+		return header>>4 <= headerEnterpriseMax
+	}
+	return isValidByron(address)
+}
+
+// IsStakeAddress checks if the address is a valid Shelley stake (reward) address.
+func IsStakeAddress(address string) bool {
+	header, ok := decodeShelley(address)
+	return ok && header>>4 >= headerStakeMin
+}
+
+// decodeShelley decodes a Shelley address and validates its header, returning the header byte.
+func decodeShelley(address string) (byte, bool) {
+	hrp, data, err := bech32.Decode(address)
+	if err != nil {
+		return 0, false
+	}
+	payload, err := bech32.ConvertBits(data, 5, 8, false)
+	if err != nil || len(payload) == 0 {
+		return 0, false
+	}
+
+	header := payload[0]
+	addrType, network := header>>4, header&0x0f
+
+	var prefix string
+	switch {
+	case addrType <= headerBaseMax:
+		prefix = "addr"
+		if len(payload) != 1+2*hashLen {
+			return 0, false
+		}
+	case addrType <= headerPointerMax:
+		prefix = "addr"
+		// the pointer is made of three variable length integers of at least a byte each
+		if len(payload) < 1+hashLen+3 {
+			return 0, false
+		}
+	case addrType <= headerEnterpriseMax:
+		prefix = "addr"
+		if len(payload) != 1+hashLen {
+			return 0, false
+		}
+	case addrType >= headerStakeMin && addrType <= headerStakeMax:
+		prefix = "stake"
+		if len(payload) != 1+hashLen {
+			return 0, false
+		}
+	default:
+		return 0, false
+	}
+
+	switch network {
+	case networkMainnet:
+	case networkTestnet:
+		prefix += "_test"
+	default:
+		return 0, false
+	}
+
+	return header, hrp == prefix
+}
+
+// isValidByron checks if the address is a valid Byron address.
+//
+// A Byron address is the base58 encoding of the CBOR array [tag24(payload), crc32(payload)].
+func isValidByron(address string) bool {
+	b, err := base58.Decode(address)
+	if err != nil {
+		return false
+	}
+
+	// array of two items, followed by tag 24 (encoded CBOR)
+	if len(b) < 3 || b[0] != 0x82 || b[1] != 0xd8 || b[2] != 0x18 {
+		return false
+	}
+	payload, rest, ok := cborBytes(b[3:])
+	if !ok {
+		return false
+	}
+	crc, rest, ok := cborUint(rest)
+	if !ok || len(rest) != 0 {
+		return false
+	}
+	return crc == uint64(crc32.ChecksumIEEE(payload))
+}
+
+// cborBytes reads a CBOR byte string, returning its content and the remaining input.
+func cborBytes(b []byte) ([]byte, []byte, bool) {
+	if len(b) == 0 || b[0]>>5 != 2 {
+		return nil, nil, false
+	}
+	n, rest, ok := cborArgument(b)
+	if !ok || uint64(len(rest)) < n {
+		return nil, nil, false
+	}
+	return rest[:n], rest[n:], true
+}
+
+// cborUint reads a CBOR unsigned integer, returning its value and the remaining input.
+func cborUint(b []byte) (uint64, []byte, bool) {
+	if len(b) == 0 || b[0]>>5 != 0 {
+		return 0, nil, false
+	}
+	return cborArgument(b)
+}
+
+// cborArgument reads the argument of a CBOR data item header.
+func cborArgument(b []byte) (uint64, []byte, bool) {
+	info := b[0] & 0x1f
+	b = b[1:]
+	switch {
+	case info < 24:
+		return uint64(info), b, true
+	case info == 24 && len(b) >= 1:
+		return uint64(b[0]), b[1:], true
+	case info == 25 && len(b) >= 2:
+		return uint64(binary.BigEndian.Uint16(b)), b[2:], true
+	case info == 26 && len(b) >= 4:
+		return uint64(binary.BigEndian.Uint32(b)), b[4:], true
+	case info == 27 && len(b) >= 8:
+		return binary.BigEndian.Uint64(b), b[8:], true
+	}
+	return 0, nil, false
+}
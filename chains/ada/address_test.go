@@ -0,0 +1,44 @@
+package ada
+
+import "testing"
+
+func TestIsValidAddress(t *testing.T) {
+	tests := []struct {
+		name    string
+		address string
+		valid   bool
+		payment bool
+		stake   bool
+	}{
+		// CIP-19 test vectors
+		{"mainnet base", "addr1qx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer3n0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgse35a3x", true, true, false},
+		{"testnet base", "addr_test1qz2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer3n0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgs68faae", true, true, false},
+		{"mainnet enterprise", "addr1vx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzers66hrl8", true, true, false},
+		{"mainnet stake", "stake1uyehkck0lajq8gr28t9uxnuvgcqrc6070x3k9r8048z8y5gh6ffgw", true, false, true},
+		{"testnet stake", "stake_test1uqehkck0lajq8gr28t9uxnuvgcqrc6070x3k9r8048z8y5gssrtvn", true, false, true},
+		{"byron mainnet", "Ae2tdPwUPEZFRbyhz3cpfC2CumGzNkFBN2L42rcUc2yjQpEkxDbkPodpMAi", true, true, false},
+		{"byron testnet", "37btjrVyb4KDXBNC4haBVPCrro8AQPHwvCMp3RFhhSVWwfFmZ6wwzSK6JK1hY6wHNmtrpTf1kdbva8TCneM2YsiXT7mrzT21EacHnPpz5YyUdj64na", true, true, false},
+
+		{"bad checksum", "addr1qx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer3n0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgse35a3y", false, false, false},
+		{"mainnet header with testnet prefix", "addr_test1qyqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq72lnre", false, false, false},
+		{"base header with stake prefix", "stake1qyqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqfd25fy", false, false, false},
+		{"unknown network", "addr1vgqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqkjxwm8", false, false, false},
+		{"truncated base", "addr1qyqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqpe45uw", false, false, false},
+		{"uppercase and lowercase mixed", "Addr1vx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzers66hrl8", false, false, false},
+		{"byron bad crc", "Ae2tdPwUPEZFRbyhz3cpfC2CumGzNkFBN2L42rcUc2yjQpEkxDbkPodpMAj", false, false, false},
+		{"empty", "", false, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsValidAddress(tt.address); got != tt.valid {
+				t.Errorf("IsValidAddress(%q) = %v, want %v", tt.address, got, tt.valid)
+			}
+			if got := IsPaymentAddress(tt.address); got != tt.payment {
+				t.Errorf("IsPaymentAddress(%q) = %v, want %v", tt.address, got, tt.payment)
+			}
+			if got := IsStakeAddress(tt.address); got != tt.stake {
+				t.Errorf("IsStakeAddress(%q) = %v, want %v", tt.address, got, tt.stake)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package algo
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"encoding/base32"
+)
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+const (
+	addressLen    = 58
+	publicKeyLen  = 32
+	checksumLen   = 4
+	decodedLength = publicKeyLen + checksumLen
+)
+
+// IsValidAddress checks if the address is a valid Algorand address.
+//
+// An address is the unpadded base32 encoding of a 32 bytes ed25519 public key followed by
+// a 4 bytes checksum, the last 4 bytes of the SHA-512/256 hash of the public key.
+func IsValidAddress(address string) bool {
+	if len(address) != addressLen {
+		return false
+	}
+	b, err := encoding.DecodeString(address)
+	// the unused trailing bits must be zero, so that each address has a single encoding
+	if err != nil || len(b) != decodedLength || encoding.EncodeToString(b) != address {
+		return false
+	}
+
+	pub, checksum := b[:publicKeyLen], b[publicKeyLen:]
+	hash := sha512.Sum512_256(pub)
+	return bytes.Equal(hash[len(hash)-checksumLen:], checksum)
+}
@@ -0,0 +1,34 @@
+package algo
+
+import "testing"
+
+func TestIsValidAddress(t *testing.T) {
+	const zero = "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAY5HFKQ"
+	tests := []struct {
+		name    string
+		address string
+		valid   bool
+	}{
+		{"zero address", zero, true},
+		{"fee sink", "Y76M3MSY6DKBRHBL7C3NNDXGS5IIMQVQVUAB6MP4XEMMGVF2QWNPL226CA", true},
+		{"rewards pool", "737777777777777777777777777777777777777777777777777UFEJ2CI", true},
+		// the public key is the SHA-256 of "key"
+		{"generated", "FRYOCK32AZDPSITZ6QT4PM4OOM2NRZJYTT7RM6Q5YMHHH6BGW2B6JX2JTA", true},
+
+		{"bad checksum", "FRYOCK32AZDPSITZ6QT4PM4OOM2NRZJYTT7RM6Q5YMHHH6BGW2B6JX2JTB", false},
+		{"altered public key", "ARYOCK32AZDPSITZ6QT4PM4OOM2NRZJYTT7RM6Q5YMHHH6BGW2B6JX2JTA", false},
+		{"non-zero trailing bits", zero[:len(zero)-1] + "R", false},
+		{"lowercase", "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaay5hfkq", false},
+		{"padded", zero + "======", false},
+		{"truncated", zero[:len(zero)-1], false},
+		{"not base32", "0" + zero[1:], false},
+		{"empty", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsValidAddress(tt.address); got != tt.valid {
+				t.Errorf("IsValidAddress(%q) = %v, want %v", tt.address, got, tt.valid)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package algo
+
+import (
+	"math/big"
+
+	"github.com/airsigner/libcrypto/types"
+	"github.com/airsigner/libcrypto/types/pb"
+	"github.com/shopspring/decimal"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+type algoDefinition struct{}
+
+func (algoDefinition) CoinName() string { return "ALGO" }
+func (algoDefinition) UnitExp() int32   { return 6 }
+
+// Denominations returns microAlgo and ALGO, see types.CoinValue.Humanize.
+func (algoDefinition) Denominations() []types.Denomination {
+	return []types.Denomination{
+		{Name: "microAlgo", Exp: 0},
+		{Name: "ALGO", Exp: 6},
+	}
+}
+
+// Denominations returns the denominations of ALGO, from the smallest to the largest, see types.CoinValue.In.
+func Denominations() []types.Denomination {
+	return algoDefinition{}.Denominations()
+}
+
+func init() {
+	types.Register(algoDefinition{}.CoinName(), func(microAlgos *big.Int) types.Value {
+		return NewAlgoFromMicroAlgos(microAlgos)
+	})
+}
+
+var _ types.Value = (*Algo)(nil)
+
+type Algo struct {
+	*types.CoinValue[algoDefinition]
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, see types.CoinValue.UnmarshalBinary.
+func (a *Algo) UnmarshalBinary(data []byte) error {
+	return types.DecodeInto(&a.CoinValue, func(v *types.CoinValue[algoDefinition]) error {
+		return v.UnmarshalBinary(data)
+	})
+}
+
+// FromProto sets the value to the value of its protobuf representation, see types.CoinValue.FromProto.
+func (a *Algo) FromProto(m *pb.CoinValue) error {
+	return types.DecodeInto(&a.CoinValue, func(v *types.CoinValue[algoDefinition]) error {
+		return v.FromProto(m)
+	})
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, see types.CoinValue.UnmarshalCBOR.
+func (a *Algo) UnmarshalCBOR(data []byte) error {
+	return types.DecodeInto(&a.CoinValue, func(v *types.CoinValue[algoDefinition]) error {
+		return v.UnmarshalCBOR(data)
+	})
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler, see types.CoinValue.UnmarshalBSONValue.
+func (a *Algo) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	return types.DecodeInto(&a.CoinValue, func(v *types.CoinValue[algoDefinition]) error {
+		return v.UnmarshalBSONValue(t, data)
+	})
+}
+
+func NewAlgo(algo decimal.Decimal) *Algo {
+	return &Algo{
+		types.NewCoinValueFromCoins[algoDefinition](algo),
+	}
+}
+
+// NewAlgoExact is like NewAlgo but fails instead of truncating an amount more precise than the smallest unit.
+func NewAlgoExact(algo decimal.Decimal) (*Algo, error) {
+	cv, err := types.NewCoinValueFromCoinsChecked[algoDefinition](algo)
+	if err != nil {
+		return nil, err
+	}
+	return &Algo{cv}, nil
+}
+
+// NewAlgoFromString parses a decimal string amount of ALGO, e.g. "1.5".
+func NewAlgoFromString(s string) (*Algo, error) {
+	cv, err := types.ParseCoinValue[algoDefinition](s)
+	if err != nil {
+		return nil, err
+	}
+	return &Algo{cv}, nil
+}
+
+// MustNewAlgo is like NewAlgoFromString but panics if s can't be parsed, intended for tests and constants.
+func MustNewAlgo(s string) *Algo {
+	return &Algo{types.MustParseCoinValue[algoDefinition](s)}
+}
+
+// NewAlgoFromValue creates an Algo from a Value of the same coin, e.g. the result of Algo.Add.
+func NewAlgoFromValue(v types.Value) (*Algo, error) {
+	cv, err := types.NewCoinValueFromValue[algoDefinition](v)
+	if err != nil {
+		return nil, err
+	}
+	return &Algo{cv}, nil
+}
+
+func NewAlgoFromMicroAlgos(microAlgos *big.Int) *Algo {
+	return &Algo{
+		types.NewCoinValue[algoDefinition](microAlgos),
+	}
+}
+
+// MicroAlgos returns the value of the Algo type in microAlgos.
+func (a Algo) MicroAlgos() *big.Int {
+	return a.Units()
+}
+
+// Algo returns the value of the Algo type in ALGO.
+func (a Algo) Algo() decimal.Decimal {
+	return a.Coins()
+}
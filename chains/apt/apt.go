@@ -0,0 +1,122 @@
+package apt
+
+import (
+	"math/big"
+
+	"github.com/airsigner/libcrypto/types"
+	"github.com/airsigner/libcrypto/types/pb"
+	"github.com/shopspring/decimal"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+type aptDefinition struct{}
+
+func (aptDefinition) CoinName() string { return "APT" }
+func (aptDefinition) UnitExp() int32   { return 8 }
+
+// Denominations returns octa and APT, see types.CoinValue.Humanize.
+func (aptDefinition) Denominations() []types.Denomination {
+	return []types.Denomination{
+		{Name: "octa", Exp: 0},
+		{Name: "APT", Exp: 8},
+	}
+}
+
+// Denominations returns the denominations of APT, from the smallest to the largest, see types.CoinValue.In.
+func Denominations() []types.Denomination {
+	return aptDefinition{}.Denominations()
+}
+
+func init() {
+	types.Register(aptDefinition{}.CoinName(), func(octas *big.Int) types.Value {
+		return NewAptFromOctas(octas)
+	})
+}
+
+var _ types.Value = (*Apt)(nil)
+
+type Apt struct {
+	*types.CoinValue[aptDefinition]
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, see types.CoinValue.UnmarshalBinary.
+func (a *Apt) UnmarshalBinary(data []byte) error {
+	return types.DecodeInto(&a.CoinValue, func(v *types.CoinValue[aptDefinition]) error {
+		return v.UnmarshalBinary(data)
+	})
+}
+
+// FromProto sets the value to the value of its protobuf representation, see types.CoinValue.FromProto.
+func (a *Apt) FromProto(m *pb.CoinValue) error {
+	return types.DecodeInto(&a.CoinValue, func(v *types.CoinValue[aptDefinition]) error {
+		return v.FromProto(m)
+	})
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, see types.CoinValue.UnmarshalCBOR.
+func (a *Apt) UnmarshalCBOR(data []byte) error {
+	return types.DecodeInto(&a.CoinValue, func(v *types.CoinValue[aptDefinition]) error {
+		return v.UnmarshalCBOR(data)
+	})
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler, see types.CoinValue.UnmarshalBSONValue.
+func (a *Apt) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	return types.DecodeInto(&a.CoinValue, func(v *types.CoinValue[aptDefinition]) error {
+		return v.UnmarshalBSONValue(t, data)
+	})
+}
+
+func NewApt(apt decimal.Decimal) *Apt {
+	return &Apt{
+		types.NewCoinValueFromCoins[aptDefinition](apt),
+	}
+}
+
+// NewAptExact is like NewApt but fails instead of truncating an amount more precise than the smallest unit.
+func NewAptExact(apt decimal.Decimal) (*Apt, error) {
+	cv, err := types.NewCoinValueFromCoinsChecked[aptDefinition](apt)
+	if err != nil {
+		return nil, err
+	}
+	return &Apt{cv}, nil
+}
+
+// NewAptFromString parses a decimal string amount of APT, e.g. "1.5".
+func NewAptFromString(s string) (*Apt, error) {
+	cv, err := types.ParseCoinValue[aptDefinition](s)
+	if err != nil {
+		return nil, err
+	}
+	return &Apt{cv}, nil
+}
+
+// MustNewApt is like NewAptFromString but panics if s can't be parsed, intended for tests and constants.
+func MustNewApt(s string) *Apt {
+	return &Apt{types.MustParseCoinValue[aptDefinition](s)}
+}
+
+// NewAptFromValue creates a Apt from a Value of the same coin, e.g. the result of Apt.Add.
+func NewAptFromValue(v types.Value) (*Apt, error) {
+	cv, err := types.NewCoinValueFromValue[aptDefinition](v)
+	if err != nil {
+		return nil, err
+	}
+	return &Apt{cv}, nil
+}
+
+func NewAptFromOctas(octas *big.Int) *Apt {
+	return &Apt{
+		types.NewCoinValue[aptDefinition](octas),
+	}
+}
+
+// Octas returns the value of the Apt type in octas.
+func (a Apt) Octas() *big.Int {
+	return a.Units()
+}
+
+// Apt returns the value of the Apt type in APT.
+func (a Apt) Apt() decimal.Decimal {
+	return a.Coins()
+}
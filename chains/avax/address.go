@@ -0,0 +1,8 @@
+package avax
+
+// IsValidAddress checks if the address is a valid Avalanche C-Chain address.
+//
+// C-Chain addresses have the same format as all EVM addresses, unlike the bech32 addresses of the X and P chains.
+func IsValidAddress(address string) bool {
+	return Chain.IsValidAddress(address)
+}
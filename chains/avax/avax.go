@@ -0,0 +1,112 @@
+package avax
+
+import (
+	"math/big"
+
+	"github.com/airsigner/libcrypto/chains/evm"
+	"github.com/airsigner/libcrypto/types"
+	"github.com/shopspring/decimal"
+)
+
+// Chain is the Avalanche C-Chain.
+var Chain = evm.NewChain("AVAX", 18, 43114)
+
+type avaxDefinition struct{}
+
+func (avaxDefinition) CoinName() string { return Chain.CoinName() }
+func (avaxDefinition) UnitExp() int32   { return Chain.UnitExp() }
+
+// Denominations returns wei, gwei and AVAX, see types.CoinValue.Humanize.
+func (avaxDefinition) Denominations() []types.Denomination {
+	return Chain.Denominations()
+}
+
+// Denominations returns the denominations of AVAX, from the smallest to the largest, see types.CoinValue.In.
+func Denominations() []types.Denomination {
+	return avaxDefinition{}.Denominations()
+}
+
+func init() {
+	types.Register(avaxDefinition{}.CoinName(), func(wei *big.Int) types.Value {
+		return NewAvaxFromWei(wei)
+	})
+}
+
+var _ types.Value = (*Avax)(nil)
+
+// Avax is an amount of AVAX, the native coin of the Avalanche C-Chain, with the wei helpers of evm.Coin.
+type Avax struct {
+	evm.Coin[avaxDefinition]
+}
+
+func newAvax(cv *types.CoinValue[avaxDefinition]) *Avax {
+	return &Avax{evm.Coin[avaxDefinition]{CoinValue: cv}}
+}
+
+// newAvaxExact wraps the result of an exact constructor of types.CoinValue.
+func newAvaxExact(cv *types.CoinValue[avaxDefinition], err error) (*Avax, error) {
+	if err != nil {
+		return nil, err
+	}
+	return newAvax(cv), nil
+}
+
+func NewAvax(avax decimal.Decimal) *Avax {
+	return newAvax(types.NewCoinValueFromCoins[avaxDefinition](avax))
+}
+
+// NewAvaxExact is like NewAvax but fails instead of truncating an amount more precise than the smallest unit.
+func NewAvaxExact(avax decimal.Decimal) (*Avax, error) {
+	return newAvaxExact(types.NewCoinValueFromCoinsChecked[avaxDefinition](avax))
+}
+
+// NewAvaxFromString parses a decimal string amount of AVAX, e.g. "1.5".
+func NewAvaxFromString(s string) (*Avax, error) {
+	return newAvaxExact(types.ParseCoinValue[avaxDefinition](s))
+}
+
+// MustNewAvax is like NewAvaxFromString but panics if s can't be parsed, intended for tests and constants.
+func MustNewAvax(s string) *Avax {
+	return newAvax(types.MustParseCoinValue[avaxDefinition](s))
+}
+
+// NewAvaxFromValue creates an Avax from a Value of the same coin, e.g. the result of Avax.Add.
+func NewAvaxFromValue(v types.Value) (*Avax, error) {
+	return newAvaxExact(types.NewCoinValueFromValue[avaxDefinition](v))
+}
+
+func NewAvaxFromWei(wei *big.Int) *Avax {
+	return newAvax(types.NewCoinValue[avaxDefinition](wei))
+}
+
+func NewAvaxFromKWei(kwei decimal.Decimal) *Avax {
+	return newAvax(types.NewCoinValueFromScaled[avaxDefinition](kwei, evm.KWeiExp))
+}
+
+// NewAvaxFromKWeiExact is like NewAvaxFromKWei but fails instead of truncating an amount more precise than the wei.
+func NewAvaxFromKWeiExact(kwei decimal.Decimal) (*Avax, error) {
+	return newAvaxExact(types.NewCoinValueFromScaledChecked[avaxDefinition](kwei, evm.KWeiExp))
+}
+
+func NewAvaxFromMWei(mwei decimal.Decimal) *Avax {
+	return newAvax(types.NewCoinValueFromScaled[avaxDefinition](mwei, evm.MWeiExp))
+}
+
+// NewAvaxFromMWeiExact is like NewAvaxFromMWei but fails instead of truncating an amount more precise than the wei.
+func NewAvaxFromMWeiExact(mwei decimal.Decimal) (*Avax, error) {
+	return newAvaxExact(types.NewCoinValueFromScaledChecked[avaxDefinition](mwei, evm.MWeiExp))
+}
+
+func NewAvaxFromGWei(gwei decimal.Decimal) *Avax {
+	return newAvax(types.NewCoinValueFromScaled[avaxDefinition](gwei, evm.GWeiExp))
+}
+
+// NewAvaxFromGWeiExact is like NewAvaxFromGWei but fails instead of truncating an amount more precise than the wei.
+func NewAvaxFromGWeiExact(gwei decimal.Decimal) (*Avax, error) {
+	return newAvaxExact(types.NewCoinValueFromScaledChecked[avaxDefinition](gwei, evm.GWeiExp))
+}
+
+// Avax returns the value of the Avax type in Avax.
+func (a Avax) Avax() decimal.Decimal {
+	return a.Coins()
+}
@@ -0,0 +1,94 @@
+package avax
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/airsigner/libcrypto/chains/eth"
+	"github.com/airsigner/libcrypto/types/valuetest"
+	"github.com/shopspring/decimal"
+)
+
+func TestAvaxValue(t *testing.T) {
+	valuetest.AssertValue(t, NewAvaxFromWei)
+}
+
+func TestAvaxScaling(t *testing.T) {
+	a := MustNewAvax("1.5")
+	if want := big.NewInt(1_500_000_000_000_000_000); a.Wei().Cmp(want) != 0 {
+		t.Errorf("Wei() = %s, want %s", a.Wei(), want)
+	}
+	for name, got := range map[string]decimal.Decimal{
+		"KWei": a.KWei(),
+		"MWei": a.MWei(),
+		"GWei": a.GWei(),
+		"Avax": a.Avax(),
+	} {
+		want := map[string]string{"KWei": "1500000000000000", "MWei": "1500000000000", "GWei": "1500000000", "Avax": "1.5"}[name]
+		if got.String() != want {
+			t.Errorf("%s() = %s, want %s", name, got, want)
+		}
+	}
+
+	ctors := map[string]*Avax{
+		"NewAvax":         NewAvax(decimal.RequireFromString("1.5")),
+		"NewAvaxFromKWei": NewAvaxFromKWei(decimal.RequireFromString("1500000000000000")),
+		"NewAvaxFromMWei": NewAvaxFromMWei(decimal.RequireFromString("1500000000000")),
+		"NewAvaxFromGWei": NewAvaxFromGWei(decimal.RequireFromString("1500000000")),
+	}
+	for name, got := range ctors {
+		if !got.Equals(a) {
+			t.Errorf("%s() = %s wei, want %s", name, got.Wei(), a.Wei())
+		}
+	}
+
+	if _, err := NewAvaxFromGWeiExact(decimal.RequireFromString("0.0000000001")); err == nil {
+		t.Errorf("NewAvaxFromGWeiExact() of a tenth of a wei succeeded, want an error")
+	}
+	if _, err := NewAvaxFromValue(eth.MustNewEth("1")); err == nil {
+		t.Errorf("NewAvaxFromValue() of ETH succeeded, want an error")
+	}
+}
+
+func TestIsValidAddressParity(t *testing.T) {
+	for _, address := range []string{
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		"0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed",
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAe",
+		"5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		"X-avax1tzdcgj4ehsvhhgpl7zylwpw0gl2rxcg4r5afk5",
+		"",
+	} {
+		if got, want := IsValidAddress(address), eth.IsValidAddress(address); got != want {
+			t.Errorf("IsValidAddress(%q) = %v, want %v like eth.IsValidAddress", address, got, want)
+		}
+	}
+}
+
+func TestAvaxBinaryRoundTrip(t *testing.T) {
+	want := MustNewAvax("1.5")
+	data, err := want.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got Avax
+	if err := got.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary() into a zero Avax: %v", err)
+	}
+	if !want.Equals(got) || got.GWei().String() != "1500000000" {
+		t.Errorf("UnmarshalBinary() = %s, want %s", got, want)
+	}
+}
+
+func TestAvaxProtoRoundTrip(t *testing.T) {
+	want := MustNewAvax("1.5")
+
+	var got Avax
+	if err := got.FromProto(want.ToProto()); err != nil {
+		t.Fatalf("FromProto() into a zero Avax: %v", err)
+	}
+	if !want.Equals(got) {
+		t.Errorf("FromProto() = %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,132 @@
+package btc
+
+import (
+	"math/big"
+
+	"github.com/airsigner/libcrypto/types"
+	"github.com/airsigner/libcrypto/types/pb"
+	"github.com/shopspring/decimal"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+type btcDefinition struct{}
+
+func (btcDefinition) CoinName() string { return "BTC" }
+func (btcDefinition) UnitExp() int32   { return 8 }
+
+// MaxUnits returns the maximum supply of Bitcoin, 21 million BTC.
+func (btcDefinition) MaxUnits() *big.Int { return big.NewInt(21_000_000 * 100_000_000) }
+
+// Denominations returns sat and BTC, see types.CoinValue.Humanize.
+func (btcDefinition) Denominations() []types.Denomination {
+	return []types.Denomination{
+		{Name: "sat", Exp: 0},
+		{Name: "BTC", Exp: 8},
+	}
+}
+
+// Denominations returns the denominations of BTC, from the smallest to the largest, see types.CoinValue.In.
+func Denominations() []types.Denomination {
+	return btcDefinition{}.Denominations()
+}
+
+// DefaultDustLimit returns the default dust limit of Bitcoin Core for P2PKH outputs, 546 sats.
+//
+// Outputs below it are considered uneconomical to spend and aren't relayed.
+func DefaultDustLimit() *Btc {
+	return NewBtcFromSats(big.NewInt(546))
+}
+
+func init() {
+	types.Register(btcDefinition{}.CoinName(), func(sats *big.Int) types.Value {
+		return NewBtcFromSats(sats)
+	})
+}
+
+var _ types.Value = (*Btc)(nil)
+
+type Btc struct {
+	*types.CoinValue[btcDefinition]
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, see types.CoinValue.UnmarshalBinary.
+func (b *Btc) UnmarshalBinary(data []byte) error {
+	return types.DecodeInto(&b.CoinValue, func(v *types.CoinValue[btcDefinition]) error {
+		return v.UnmarshalBinary(data)
+	})
+}
+
+// FromProto sets the value to the value of its protobuf representation, see types.CoinValue.FromProto.
+func (b *Btc) FromProto(m *pb.CoinValue) error {
+	return types.DecodeInto(&b.CoinValue, func(v *types.CoinValue[btcDefinition]) error {
+		return v.FromProto(m)
+	})
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, see types.CoinValue.UnmarshalCBOR.
+func (b *Btc) UnmarshalCBOR(data []byte) error {
+	return types.DecodeInto(&b.CoinValue, func(v *types.CoinValue[btcDefinition]) error {
+		return v.UnmarshalCBOR(data)
+	})
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler, see types.CoinValue.UnmarshalBSONValue.
+func (b *Btc) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	return types.DecodeInto(&b.CoinValue, func(v *types.CoinValue[btcDefinition]) error {
+		return v.UnmarshalBSONValue(t, data)
+	})
+}
+
+func NewBtc(btc decimal.Decimal) *Btc {
+	return &Btc{
+		types.NewCoinValueFromCoins[btcDefinition](btc),
+	}
+}
+
+// NewBtcExact is like NewBtc but fails instead of truncating an amount more precise than the smallest unit.
+func NewBtcExact(btc decimal.Decimal) (*Btc, error) {
+	cv, err := types.NewCoinValueFromCoinsChecked[btcDefinition](btc)
+	if err != nil {
+		return nil, err
+	}
+	return &Btc{cv}, nil
+}
+
+// NewBtcFromString parses a decimal string amount of BTC, e.g. "1.5".
+func NewBtcFromString(s string) (*Btc, error) {
+	cv, err := types.ParseCoinValue[btcDefinition](s)
+	if err != nil {
+		return nil, err
+	}
+	return &Btc{cv}, nil
+}
+
+// MustNewBtc is like NewBtcFromString but panics if s can't be parsed, intended for tests and constants.
+func MustNewBtc(s string) *Btc {
+	return &Btc{types.MustParseCoinValue[btcDefinition](s)}
+}
+
+// NewBtcFromValue creates a Btc from a Value of the same coin, e.g. the result of Btc.Add.
+func NewBtcFromValue(v types.Value) (*Btc, error) {
+	cv, err := types.NewCoinValueFromValue[btcDefinition](v)
+	if err != nil {
+		return nil, err
+	}
+	return &Btc{cv}, nil
+}
+
+func NewBtcFromSats(sats *big.Int) *Btc {
+	return &Btc{
+		types.NewCoinValue[btcDefinition](sats),
+	}
+}
+
+// Sats returns the value of the Btc type in satoshis.
+func (b Btc) Sats() *big.Int {
+	return b.Units()
+}
+
+// Btc returns the value of the Btc type in BTC.
+func (b Btc) Btc() decimal.Decimal {
+	return b.Coins()
+}
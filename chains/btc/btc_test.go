@@ -0,0 +1,24 @@
+package btc
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestDefaultDustLimit(t *testing.T) {
+	tests := []struct {
+		sats int64
+		dust bool
+	}{
+		{545, true},
+		{546, false},
+		{547, false},
+		{0, false},
+	}
+	for _, tt := range tests {
+		got, err := NewBtcFromSats(big.NewInt(tt.sats)).IsDust(DefaultDustLimit())
+		if err != nil || got != tt.dust {
+			t.Errorf("IsDust(DefaultDustLimit()) of %d sats = %t, %v, want %t", tt.sats, got, err, tt.dust)
+		}
+	}
+}
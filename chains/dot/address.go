@@ -0,0 +1,77 @@
+package dot
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/airsigner/libcrypto/internal/base58"
+	"golang.org/x/crypto/blake2b"
+)
+
+const (
+	// PolkadotPrefix is the SS58 network prefix of Polkadot.
+	PolkadotPrefix uint16 = 0
+	// KusamaPrefix is the SS58 network prefix of Kusama.
+	KusamaPrefix uint16 = 2
+	// DefaultPrefix can be passed to IsValidAddress to validate against the Polkadot prefix.
+	DefaultPrefix uint16 = 0xffff
+
+	maxPrefix   = 16383
+	checksumLen = 2
+)
+
+var ss58Pre = []byte("SS58PRE")
+
+// IsValidAddress checks if the address is a valid SS58 account address for the given network prefix.
+//
+// The address must encode a 32 bytes account ID (or a 33 bytes ECDSA public key),
+// carry the expected network prefix and have a valid Blake2b checksum.
+//
+// Parameters:
+// - address: the address to validate.
+// - networkPrefix: the SS58 prefix of the network, or DefaultPrefix for Polkadot.
+//
+// Returns:
+// - bool: true if the address is valid, false otherwise.
+// - error: an error if the network prefix is out of the SS58 range.
+func IsValidAddress(address string, networkPrefix uint16) (bool, error) {
+	if networkPrefix == DefaultPrefix {
+		networkPrefix = PolkadotPrefix
+	}
+	if networkPrefix > maxPrefix {
+		return false, fmt.Errorf("invalid SS58 network prefix %d", networkPrefix)
+	}
+
+	b, err := base58.Decode(address)
+	if err != nil || len(b) < 1 {
+		return false, nil
+	}
+
+	prefix, prefixLen, ok := decodePrefix(b)
+	if !ok || prefix != networkPrefix {
+		return false, nil
+	}
+
+	switch len(b) - prefixLen - checksumLen {
+	case 32, 33:
+	default:
+		return false, nil
+	}
+
+	data, checksum := b[:len(b)-checksumLen], b[len(b)-checksumLen:]
+	hash := blake2b.Sum512(append(append([]byte{}, ss58Pre...), data...))
+	return bytes.Equal(hash[:checksumLen], checksum), nil
+}
+
+// decodePrefix decodes the SS58 network prefix, returning it along with its encoded length.
+func decodePrefix(b []byte) (uint16, int, bool) {
+	switch {
+	case b[0] < 64:
+		return uint16(b[0]), 1, true
+	case b[0] < 128 && len(b) >= 2:
+		lower := uint16(b[0]&0x3f)<<2 | uint16(b[1]>>6)
+		upper := uint16(b[1] & 0x3f)
+		return lower | upper<<8, 2, true
+	}
+	return 0, 0, false
+}
@@ -0,0 +1,43 @@
+package dot
+
+import "testing"
+
+func TestIsValidAddress(t *testing.T) {
+	// the account of the well-known //Alice development key on several networks
+	tests := []struct {
+		name    string
+		address string
+		prefix  uint16
+		valid   bool
+	}{
+		{"polkadot", "15oF4uVJwmo4TdGW7VfQxNLavjCXviqxT9S1MgbjMNHr6Sp5", PolkadotPrefix, true},
+		{"default prefix", "15oF4uVJwmo4TdGW7VfQxNLavjCXviqxT9S1MgbjMNHr6Sp5", DefaultPrefix, true},
+		{"kusama", "HNZata7iMYWmk5RvZRTiAsSDhV8366zq2YGb3tLH5Upf74F", KusamaPrefix, true},
+		{"generic substrate", "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY", 42, true},
+		{"two bytes prefix", "vji5kpxBaPKwct6PAdHiJUPCU1hqBEAPaLMF59sXAjn4NeEaJ", 1000, true},
+
+		{"kusama address on polkadot", "HNZata7iMYWmk5RvZRTiAsSDhV8366zq2YGb3tLH5Upf74F", PolkadotPrefix, false},
+		{"polkadot address on kusama", "15oF4uVJwmo4TdGW7VfQxNLavjCXviqxT9S1MgbjMNHr6Sp5", KusamaPrefix, false},
+		{"bad checksum", "15oF4uVJwmo4TdGW7VfQxNLavjCXviqxT9S1MgbjMNHr6H8K", PolkadotPrefix, false},
+		{"short account ID", "1263HHspZoQ88PiaL9JmPy3AxDjVJcJtXr33M6M6yGhX5bB", PolkadotPrefix, false},
+		{"not base58", "15oF4uVJwmo4TdGW7VfQxNLavjCXviqxT9S1MgbjMNHr6Sp0", PolkadotPrefix, false},
+		{"empty", "", PolkadotPrefix, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IsValidAddress(tt.address, tt.prefix)
+			if err != nil {
+				t.Fatalf("IsValidAddress(%q, %d): %v", tt.address, tt.prefix, err)
+			}
+			if got != tt.valid {
+				t.Errorf("IsValidAddress(%q, %d) = %v, want %v", tt.address, tt.prefix, got, tt.valid)
+			}
+		})
+	}
+}
+
+func TestIsValidAddressInvalidPrefix(t *testing.T) {
+	if _, err := IsValidAddress("15oF4uVJwmo4TdGW7VfQxNLavjCXviqxT9S1MgbjMNHr6Sp5", maxPrefix+1); err == nil {
+		t.Errorf("IsValidAddress() with prefix %d succeeded, want an error", maxPrefix+1)
+	}
+}
@@ -0,0 +1,122 @@
+package dot
+
+import (
+	"math/big"
+
+	"github.com/airsigner/libcrypto/types"
+	"github.com/airsigner/libcrypto/types/pb"
+	"github.com/shopspring/decimal"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+type dotDefinition struct{}
+
+func (dotDefinition) CoinName() string { return "DOT" }
+func (dotDefinition) UnitExp() int32   { return 10 }
+
+// Denominations returns planck and DOT, see types.CoinValue.Humanize.
+func (dotDefinition) Denominations() []types.Denomination {
+	return []types.Denomination{
+		{Name: "planck", Exp: 0},
+		{Name: "DOT", Exp: 10},
+	}
+}
+
+// Denominations returns the denominations of DOT, from the smallest to the largest, see types.CoinValue.In.
+func Denominations() []types.Denomination {
+	return dotDefinition{}.Denominations()
+}
+
+func init() {
+	types.Register(dotDefinition{}.CoinName(), func(planck *big.Int) types.Value {
+		return NewDotFromPlanck(planck)
+	})
+}
+
+var _ types.Value = (*Dot)(nil)
+
+type Dot struct {
+	*types.CoinValue[dotDefinition]
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, see types.CoinValue.UnmarshalBinary.
+func (d *Dot) UnmarshalBinary(data []byte) error {
+	return types.DecodeInto(&d.CoinValue, func(v *types.CoinValue[dotDefinition]) error {
+		return v.UnmarshalBinary(data)
+	})
+}
+
+// FromProto sets the value to the value of its protobuf representation, see types.CoinValue.FromProto.
+func (d *Dot) FromProto(m *pb.CoinValue) error {
+	return types.DecodeInto(&d.CoinValue, func(v *types.CoinValue[dotDefinition]) error {
+		return v.FromProto(m)
+	})
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, see types.CoinValue.UnmarshalCBOR.
+func (d *Dot) UnmarshalCBOR(data []byte) error {
+	return types.DecodeInto(&d.CoinValue, func(v *types.CoinValue[dotDefinition]) error {
+		return v.UnmarshalCBOR(data)
+	})
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler, see types.CoinValue.UnmarshalBSONValue.
+func (d *Dot) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	return types.DecodeInto(&d.CoinValue, func(v *types.CoinValue[dotDefinition]) error {
+		return v.UnmarshalBSONValue(t, data)
+	})
+}
+
+func NewDot(dot decimal.Decimal) *Dot {
+	return &Dot{
+		types.NewCoinValueFromCoins[dotDefinition](dot),
+	}
+}
+
+// NewDotExact is like NewDot but fails instead of truncating an amount more precise than the smallest unit.
+func NewDotExact(dot decimal.Decimal) (*Dot, error) {
+	cv, err := types.NewCoinValueFromCoinsChecked[dotDefinition](dot)
+	if err != nil {
+		return nil, err
+	}
+	return &Dot{cv}, nil
+}
+
+// NewDotFromString parses a decimal string amount of DOT, e.g. "1.5".
+func NewDotFromString(s string) (*Dot, error) {
+	cv, err := types.ParseCoinValue[dotDefinition](s)
+	if err != nil {
+		return nil, err
+	}
+	return &Dot{cv}, nil
+}
+
+// MustNewDot is like NewDotFromString but panics if s can't be parsed, intended for tests and constants.
+func MustNewDot(s string) *Dot {
+	return &Dot{types.MustParseCoinValue[dotDefinition](s)}
+}
+
+// NewDotFromValue creates a Dot from a Value of the same coin, e.g. the result of Dot.Add.
+func NewDotFromValue(v types.Value) (*Dot, error) {
+	cv, err := types.NewCoinValueFromValue[dotDefinition](v)
+	if err != nil {
+		return nil, err
+	}
+	return &Dot{cv}, nil
+}
+
+func NewDotFromPlanck(planck *big.Int) *Dot {
+	return &Dot{
+		types.NewCoinValue[dotDefinition](planck),
+	}
+}
+
+// Planck returns the value of the Dot type in Planck.
+func (d Dot) Planck() *big.Int {
+	return d.Units()
+}
+
+// Dot returns the value of the Dot type in Dot.
+func (d Dot) Dot() decimal.Decimal {
+	return d.Coins()
+}
@@ -0,0 +1,100 @@
+package eth
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/airsigner/libcrypto/types"
+)
+
+var (
+	// transferSelector is the selector of the ERC-20 transfer(address,uint256) function,
+	// i.e. the first 4 bytes of its signature's Keccak-256 hash.
+	transferSelector = []byte{0xa9, 0x05, 0x9c, 0xbb}
+
+	// disperseEtherSelector is the selector of the Disperse disperseEther(address[],uint256[]) function.
+	disperseEtherSelector = []byte{0xe6, 0x3d, 0x38, 0xed}
+)
+
+const abiWordLen = 32
+
+// PackTransfer returns the calldata of a call to the ERC-20 transfer(address,uint256) function.
+//
+// Parameters:
+// - to: the recipient of the transfer.
+// - amount: the amount to transfer, in the units of the token.
+//
+// Returns:
+// - []byte: the calldata, the function selector followed by the ABI encoded arguments.
+// - error: an error if the amount can't be packed as a uint256, see types.PackUint256.
+func PackTransfer(to Address, amount types.Value) ([]byte, error) {
+	packed, err := types.PackUint256(amount)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]byte, 0, len(transferSelector)+32+32)
+	data = append(data, transferSelector...)
+	data = append(data, make([]byte, 32-len(to))...)
+	data = append(data, to[:]...)
+	return append(data, packed...), nil
+}
+
+// BuildMultiSend returns the calldata of a call to the disperseEther(address[],uint256[]) function
+// of a Disperse contract, sending an amount of Ether to each recipient in a single transaction.
+//
+// Parameters:
+// - recipients: the addresses of the recipients.
+// - amounts: the amount sent to each recipient, in the order of recipients.
+//
+// Returns:
+// - []byte: the calldata, the function selector followed by the ABI encoded arrays.
+// - *Eth: the total of the amounts, to be set as the value of the transaction.
+// - error: an error if there is no recipient, recipients and amounts have different lengths,
+// a recipient is invalid or an amount is nil or can't be packed as a uint256.
+func BuildMultiSend(recipients []string, amounts []*Eth) ([]byte, *Eth, error) {
+	if len(recipients) == 0 {
+		return nil, nil, errors.New("no recipients")
+	}
+	if len(recipients) != len(amounts) {
+		return nil, nil, fmt.Errorf("%d recipients but %d amounts", len(recipients), len(amounts))
+	}
+
+	n := len(recipients)
+	data := make([]byte, 0, len(disperseEtherSelector)+(4+2*n)*abiWordLen)
+	data = append(data, disperseEtherSelector...)
+	// head: the offsets of both arrays, relative to the start of the arguments
+	data = appendUint(data, 2*abiWordLen)
+	data = appendUint(data, uint64((3+n)*abiWordLen))
+
+	data = appendUint(data, uint64(n))
+	for i, recipient := range recipients {
+		addr, err := ParseAddress(recipient)
+		if err != nil {
+			return nil, nil, fmt.Errorf("recipient %d: %w", i, err)
+		}
+		data = append(data, make([]byte, abiWordLen-len(addr))...)
+		data = append(data, addr[:]...)
+	}
+
+	total := new(big.Int)
+	data = appendUint(data, uint64(n))
+	for i, amount := range amounts {
+		if amount == nil {
+			return nil, nil, fmt.Errorf("amount %d: %w", i, types.ErrNilValue)
+		}
+		packed, err := types.PackUint256(amount)
+		if err != nil {
+			return nil, nil, fmt.Errorf("amount %d: %w", i, err)
+		}
+		data = append(data, packed...)
+		total.Add(total, amount.Wei())
+	}
+	return data, NewEthFromWei(total), nil
+}
+
+// appendUint appends n ABI encoded as a uint256.
+func appendUint(data []byte, n uint64) []byte {
+	return append(data, new(big.Int).SetUint64(n).FillBytes(make([]byte, abiWordLen))...)
+}
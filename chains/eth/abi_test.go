@@ -0,0 +1,90 @@
+package eth
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/airsigner/libcrypto/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestBuildMultiSend(t *testing.T) {
+	recipients := []string{"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359"}
+	amounts := []*Eth{MustNewEth("1.5"), NewEthFromWei(big.NewInt(1))}
+
+	data, total, err := BuildMultiSend(recipients, amounts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total.Wei().String() != "1500000000000000001" {
+		t.Errorf("BuildMultiSend() total = %s wei, want 1500000000000000001", total.Wei())
+	}
+
+	if got := hex.EncodeToString(data[:4]); got != "e63d38ed" {
+		t.Errorf("selector = %s, want e63d38ed", got)
+	}
+	if want := crypto.Keccak256([]byte("disperseEther(address[],uint256[])"))[:4]; !bytes.Equal(data[:4], want) {
+		t.Errorf("selector = %x, want %x", data[:4], want)
+	}
+
+	// head, then each array as its length followed by its elements
+	word := func(s string) string { return strings.Repeat("0", 64-len(s)) + s }
+	wantWords := []string{
+		word("40"),
+		word("a0"),
+		word("2"),
+		word(strings.ToLower(recipients[0][2:])),
+		word(strings.ToLower(recipients[1][2:])),
+		word("2"),
+		word("14d1120d7b160000"),
+		word("1"),
+	}
+	if got, want := hex.EncodeToString(data[4:]), strings.Join(wantWords, ""); got != want {
+		t.Errorf("BuildMultiSend() arguments =\n%s\nwant\n%s", got, want)
+	}
+
+	// the layout matches the encoding of go-ethereum
+	parsed, err := abi.JSON(strings.NewReader(`[{"type":"function","name":"disperseEther","inputs":[{"name":"recipients","type":"address[]"},{"name":"values","type":"uint256[]"}]}]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := parsed.Pack("disperseEther",
+		[]common.Address{common.HexToAddress(recipients[0]), common.HexToAddress(recipients[1])},
+		[]*big.Int{amounts[0].Wei(), amounts[1].Wei()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, want) {
+		t.Errorf("BuildMultiSend() = %x, want %x", data, want)
+	}
+}
+
+func TestBuildMultiSendErrors(t *testing.T) {
+	recipient := "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+	tests := []struct {
+		name       string
+		recipients []string
+		amounts    []*Eth
+		wantErr    error
+	}{
+		{"no recipients", nil, nil, nil},
+		{"length mismatch", []string{recipient}, []*Eth{MustNewEth("1"), MustNewEth("2")}, nil},
+		{"invalid recipient", []string{"0x1234"}, []*Eth{MustNewEth("1")}, nil},
+		{"nil amount", []string{recipient}, []*Eth{nil}, types.ErrNilValue},
+		{"negative amount", []string{recipient}, []*Eth{MustNewEth("-1")}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := BuildMultiSend(tt.recipients, tt.amounts)
+			if err == nil || (tt.wantErr != nil && !errors.Is(err, tt.wantErr)) {
+				t.Errorf("BuildMultiSend() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -2,32 +2,98 @@ package eth
 
 import (
 	"context"
+	"crypto/ecdsa"
 	"errors"
 	"fmt"
-	"regexp"
+	"strings"
 
-	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/airsigner/libcrypto/chains/evm"
 )
 
-var (
-	addrRegex = regexp.MustCompile("^0x[0-9a-fA-F]{40}$")
-)
+// ErrInvalidAddress is returned when an address isn't a valid Ethereum address.
+var ErrInvalidAddress = evm.ErrInvalidAddress
+
+// Address is a 20 bytes Ethereum address, see evm.Address.
+type Address = evm.Address
 
+// IsValidAddress checks if the address is a valid Ethereum address, see evm.IsValidAddress.
 func IsValidAddress(address string) bool {
-	return addrRegex.MatchString(address)
+	return evm.IsValidAddress(address)
+}
+
+// ParseAddress parses an Ethereum address, see evm.ParseAddress.
+func ParseAddress(address string) (Address, error) {
+	return evm.ParseAddress(address)
 }
 
-func IsSmartContract(address string, client *ethclient.Client) (bool, error) {
-	return IsSmartContractCtx(context.Background(), address, client)
+// ChecksumAddress returns the EIP-55 checksummed form of the address, see evm.ChecksumAddress.
+func ChecksumAddress(address string) (string, error) {
+	return evm.ChecksumAddress(address)
 }
 
-func IsSmartContractCtx(ctx context.Context, address string, client *ethclient.Client) (bool, error) {
-	if !IsValidAddress(address) {
-		return false, errors.New("invalid address")
+// AddressFromPubKey returns the address of a secp256k1 public key, see evm.AddressFromPubKey.
+func AddressFromPubKey(pub *ecdsa.PublicKey) Address {
+	return evm.AddressFromPubKey(pub)
+}
+
+// AddressFromPubKeyBytes returns the address of an encoded secp256k1 public key, see evm.AddressFromPubKeyBytes.
+func AddressFromPubKeyBytes(pub []byte) (Address, error) {
+	return evm.AddressFromPubKeyBytes(pub)
+}
+
+const defaultShortLen = 4
+
+// ShortAddress returns the checksummed address shortened for display, e.g. "0x1234…abcd".
+//
+// Parameters:
+// - address: the address to shorten.
+// - lead: the number of hex characters kept at the start, 4 if zero.
+// - tail: the number of hex characters kept at the end, 4 if zero.
+//
+// Returns:
+// - string: the shortened address, or the full checksummed address if it isn't longer than requested.
+// - error: an error if the address is invalid or lead or tail is negative.
+func ShortAddress(address string, lead, tail int) (string, error) {
+	if lead < 0 || tail < 0 {
+		return "", errors.New("negative shortened address length")
+	}
+	if lead == 0 {
+		lead = defaultShortLen
+	}
+	if tail == 0 {
+		tail = defaultShortLen
+	}
+
+	addr, err := ChecksumAddress(address)
+	if err != nil {
+		return "", err
+	}
+
+	hex := addr[2:]
+	if lead+tail >= len(hex) {
+		return addr, nil
+	}
+	return "0x" + hex[:lead] + "…" + hex[len(hex)-tail:], nil
+}
+
+// IsSmartContract checks if the address is a smart contract, i.e. has code, giving up after 30 seconds.
+//
+// Deprecated: use IsSmartContractCtx, which can be canceled and given a deadline.
+func IsSmartContract(address string, client CodeReader) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultDialTimeout)
+	defer cancel()
+	return IsSmartContractCtx(ctx, address, client)
+}
+
+// IsSmartContractCtx checks if the address is a smart contract, i.e. has code at the latest block.
+//
+// The call is bound to ctx, it returns as soon as ctx is canceled or its deadline is exceeded.
+func IsSmartContractCtx(ctx context.Context, address string, client CodeReader) (bool, error) {
+	addr, err := ParseAddress(address)
+	if err != nil {
+		return false, err
 	}
 
-	addr := common.HexToAddress(address)
 	byteCode, err := client.CodeAt(ctx, addr, nil)
 	if err != nil {
 		err = fmt.Errorf("failed to get bytecode: %w", err)
@@ -35,3 +101,60 @@ func IsSmartContractCtx(ctx context.Context, address string, client *ethclient.C
 	}
 	return len(byteCode) > 0, nil
 }
+
+var (
+	// ErrAddressPrefix is returned by ValidateAddresses for an address without the 0x prefix.
+	ErrAddressPrefix = fmt.Errorf("%w: missing 0x prefix", ErrInvalidAddress)
+	// ErrAddressLength is returned by ValidateAddresses for an address not having 40 hex characters.
+	ErrAddressLength = fmt.Errorf("%w: bad length", ErrInvalidAddress)
+	// ErrAddressHex is returned by ValidateAddresses for an address with a non hex character.
+	ErrAddressHex = fmt.Errorf("%w: bad hex character", ErrInvalidAddress)
+	// ErrAddressChecksum is returned by ValidateAddresses for a mixed case address with an invalid EIP-55 checksum.
+	ErrAddressChecksum = fmt.Errorf("%w: bad checksum", ErrInvalidAddress)
+)
+
+// AddressResult is the result of the validation of an address by ValidateAddresses.
+type AddressResult struct {
+	Address string
+	// Err is nil if the address is valid, and otherwise wraps ErrInvalidAddress and one of the specific address errors.
+	Err error
+}
+
+// ValidateAddresses validates each of the addresses, reporting why the invalid ones are, e.g. to point at the bad rows of an import.
+//
+// Parameters:
+// - addresses: the addresses to validate.
+// - checksum: whether to verify the EIP-55 checksum of mixed case addresses; all lowercase or all uppercase
+// addresses carry no checksum and are always accepted.
+//
+// Returns:
+// - []AddressResult: the result of each address, in the order of addresses.
+func ValidateAddresses(addresses []string, checksum bool) []AddressResult {
+	results := make([]AddressResult, len(addresses))
+	for i, address := range addresses {
+		results[i] = AddressResult{Address: address, Err: validateAddress(address, checksum)}
+	}
+	return results
+}
+
+func validateAddress(address string, checksum bool) error {
+	hex, ok := strings.CutPrefix(address, "0x")
+	if !ok {
+		return ErrAddressPrefix
+	}
+	if len(hex) != 40 {
+		return fmt.Errorf("%w: got %d hex characters", ErrAddressLength, len(hex))
+	}
+	for i, c := range hex {
+		if !('0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F') {
+			return fmt.Errorf("%w %q at position %d", ErrAddressHex, c, i+2)
+		}
+	}
+
+	if checksum && hex != strings.ToLower(hex) && hex != strings.ToUpper(hex) {
+		if sum, _ := ChecksumAddress(address); sum != address {
+			return ErrAddressChecksum
+		}
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package eth
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestShortAddress(t *testing.T) {
+	const address = "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+	tests := []struct {
+		address    string
+		lead, tail int
+		want       string
+	}{
+		{address, 0, 0, "0x5aAe…eAed"},
+		{strings.ToLower(address), 0, 0, "0x5aAe…eAed"},
+		{address, 6, 2, "0x5aAeb6…ed"},
+		{address, 20, 20, address},
+		{address, 40, 1, address},
+	}
+	for _, tt := range tests {
+		got, err := ShortAddress(tt.address, tt.lead, tt.tail)
+		if err != nil || got != tt.want {
+			t.Errorf("ShortAddress(%s, %d, %d) = %q, %v, want %q", tt.address, tt.lead, tt.tail, got, err, tt.want)
+		}
+	}
+
+	for _, tt := range []struct {
+		address    string
+		lead, tail int
+	}{
+		{"0x1234", 0, 0},
+		{address, -1, 4},
+		{address, 4, -1},
+	} {
+		if got, err := ShortAddress(tt.address, tt.lead, tt.tail); err == nil {
+			t.Errorf("ShortAddress(%s, %d, %d) = %q, want an error", tt.address, tt.lead, tt.tail, got)
+		}
+	}
+}
+
+func TestValidateAddresses(t *testing.T) {
+	const address = "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+	tests := []struct {
+		address    string
+		checksum   error
+		noChecksum error
+	}{
+		{address, nil, nil},
+		{strings.ToLower(address), nil, nil},
+		{"0x" + strings.ToUpper(address[2:]), nil, nil},
+		// the case of the last character flipped
+		{address[:len(address)-1] + "D", ErrAddressChecksum, nil},
+		{address[2:], ErrAddressPrefix, ErrAddressPrefix},
+		{address[:len(address)-1], ErrAddressLength, ErrAddressLength},
+		{address[:len(address)-1] + "g", ErrAddressHex, ErrAddressHex},
+		{"", ErrAddressPrefix, ErrAddressPrefix},
+	}
+	addresses := make([]string, len(tests))
+	for i, tt := range tests {
+		addresses[i] = tt.address
+	}
+
+	for _, checksum := range []bool{true, false} {
+		results := ValidateAddresses(addresses, checksum)
+		if len(results) != len(tests) {
+			t.Fatalf("ValidateAddresses() returned %d results, want %d", len(results), len(tests))
+		}
+		for i, tt := range tests {
+			want := tt.noChecksum
+			if checksum {
+				want = tt.checksum
+			}
+			got := results[i]
+			if got.Address != tt.address {
+				t.Errorf("result %d is for %q, want %q", i, got.Address, tt.address)
+			}
+			if !errors.Is(got.Err, want) || (want == nil) != (got.Err == nil) {
+				t.Errorf("ValidateAddresses(%q, %t) error = %v, want %v", tt.address, checksum, got.Err, want)
+			}
+			if got.Err != nil && !errors.Is(got.Err, ErrInvalidAddress) {
+				t.Errorf("ValidateAddresses(%q, %t) error = %v, doesn't wrap ErrInvalidAddress", tt.address, checksum, got.Err)
+			}
+		}
+	}
+}
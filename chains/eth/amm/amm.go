@@ -0,0 +1,99 @@
+// Package amm quotes swaps on constant-product automated market makers, such as Uniswap v2 pairs.
+package amm
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/airsigner/libcrypto/types"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	// ErrInsufficientLiquidity is returned when a reserve of the pair isn't positive.
+	ErrInsufficientLiquidity = errors.New("insufficient liquidity")
+
+	// ErrInsufficientInputAmount is returned when the input amount isn't positive.
+	ErrInsufficientInputAmount = errors.New("insufficient input amount")
+
+	// ErrInvalidFee is returned when the fee isn't in [0, 10000) basis points.
+	ErrInvalidFee = errors.New("invalid fee")
+)
+
+const (
+	bpsDenominator = 10_000
+
+	// impactPrecision is the number of decimal places of the price impact
+	impactPrecision = 18
+)
+
+// GetAmountOut returns the output amount of a swap on a constant-product pair, as the getAmountOut of the
+// Uniswap v2 library: the pair keeps x * y = k after taking the fee on the input amount, rounding down.
+//
+// Parameters:
+// - amountIn: the amount swapped, in the coin of reserveIn.
+// - reserveIn: the reserve of the pair in the input coin.
+// - reserveOut: the reserve of the pair in the output coin.
+// - feeBps: the fee in basis points, 30 for the 0.3% of Uniswap v2.
+//
+// Returns:
+// - types.Value: the output amount, in the coin of reserveOut.
+// - error: a *types.MismatchError if amountIn and reserveIn are different coins, an error wrapping types.ErrNilValue
+// if a value is nil, ErrInsufficientInputAmount, ErrInsufficientLiquidity or ErrInvalidFee.
+func GetAmountOut(amountIn, reserveIn, reserveOut types.Value, feeBps int) (types.Value, error) {
+	if err := validate(amountIn, reserveIn, reserveOut, feeBps); err != nil {
+		return nil, err
+	}
+
+	amountInWithFee := new(big.Int).Mul(amountIn.Units(), big.NewInt(int64(bpsDenominator-feeBps)))
+	denominator := new(big.Int).Mul(reserveIn.Units(), big.NewInt(bpsDenominator))
+	denominator.Add(denominator, amountInWithFee)
+	return reserveOut.MulScalar(amountInWithFee).DivScalar(denominator), nil
+}
+
+// PriceImpact returns the price impact of a swap on a constant-product pair, see GetAmountOut.
+//
+// The price impact is the relative difference between the output amount at the mid price of the pair,
+// reserveOut / reserveIn, and the actual output amount, fee included, e.g. 0.01 for 1%.
+//
+// Parameters:
+// - amountIn: the amount swapped, in the coin of reserveIn.
+// - reserveIn: the reserve of the pair in the input coin.
+// - reserveOut: the reserve of the pair in the output coin.
+// - feeBps: the fee in basis points.
+//
+// Returns:
+// - decimal.Decimal: the price impact in [0, 1), rounded to 18 decimal places.
+// - error: the error of GetAmountOut.
+func PriceImpact(amountIn, reserveIn, reserveOut types.Value, feeBps int) (decimal.Decimal, error) {
+	amountOut, err := GetAmountOut(amountIn, reserveIn, reserveOut, feeBps)
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+
+	// quoted = amountIn * reserveOut / reserveIn, impact = (quoted - amountOut) / quoted
+	quoted := new(big.Int).Mul(amountIn.Units(), reserveOut.Units())
+	actual := new(big.Int).Mul(amountOut.Units(), reserveIn.Units())
+	return decimal.NewFromBigInt(new(big.Int).Sub(quoted, actual), 0).
+		DivRound(decimal.NewFromBigInt(quoted, 0), impactPrecision), nil
+}
+
+func validate(amountIn, reserveIn, reserveOut types.Value, feeBps int) error {
+	if types.IsNil(amountIn) || types.IsNil(reserveIn) || types.IsNil(reserveOut) {
+		return fmt.Errorf("swap: %w", types.ErrNilValue)
+	}
+	if !amountIn.Same(reserveIn) {
+		return types.NewMismatchError("swap", amountIn, reserveIn)
+	}
+	if feeBps < 0 || feeBps >= bpsDenominator {
+		return fmt.Errorf("%w: %d bps", ErrInvalidFee, feeBps)
+	}
+	if amountIn.Units().Sign() <= 0 {
+		return ErrInsufficientInputAmount
+	}
+	if reserveIn.Units().Sign() <= 0 || reserveOut.Units().Sign() <= 0 {
+		return ErrInsufficientLiquidity
+	}
+	return nil
+}
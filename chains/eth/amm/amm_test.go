@@ -0,0 +1,120 @@
+package amm
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/airsigner/libcrypto/chains/eth"
+	"github.com/airsigner/libcrypto/types"
+	"github.com/shopspring/decimal"
+)
+
+// bridgedDefinition is ETH scoped to another network, so it isn't the same coin as eth.Eth.
+type bridgedDefinition struct{}
+
+func (bridgedDefinition) CoinName() string  { return "ETH" }
+func (bridgedDefinition) UnitExp() int32    { return 18 }
+func (bridgedDefinition) Namespace() string { return "bridge" }
+
+func TestGetAmountOut(t *testing.T) {
+	got, err := GetAmountOut(eth.MustNewEth("1"), eth.MustNewEth("100"), eth.MustNewEth("200"), 30)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "1974316068794122597"; got.Units().String() != want {
+		t.Errorf("GetAmountOut() = %s units, want %s", got.Units(), want)
+	}
+}
+
+func TestGetAmountOutNilValues(t *testing.T) {
+	one := eth.MustNewEth("1")
+	nilValues := []struct {
+		name  string
+		value types.Value
+	}{
+		{"nil", nil},
+		{"nil *eth.Eth", (*eth.Eth)(nil)},
+	}
+	for _, nv := range nilValues {
+		tests := []struct {
+			name                            string
+			amountIn, reserveIn, reserveOut types.Value
+		}{
+			{"amount in", nv.value, one, one},
+			{"reserve in", one, nv.value, one},
+			{"reserve out", one, one, nv.value},
+		}
+		for _, tt := range tests {
+			t.Run(nv.name+"/"+tt.name, func(t *testing.T) {
+				if _, err := GetAmountOut(tt.amountIn, tt.reserveIn, tt.reserveOut, 30); !errors.Is(err, types.ErrNilValue) {
+					t.Errorf("GetAmountOut() error = %v, want types.ErrNilValue", err)
+				}
+				if _, err := PriceImpact(tt.amountIn, tt.reserveIn, tt.reserveOut, 30); !errors.Is(err, types.ErrNilValue) {
+					t.Errorf("PriceImpact() error = %v, want types.ErrNilValue", err)
+				}
+			})
+		}
+	}
+}
+
+func TestGetAmountOutInsufficientLiquidity(t *testing.T) {
+	one, zero := eth.MustNewEth("1"), eth.MustNewEth("0")
+	tests := []struct {
+		name                  string
+		reserveIn, reserveOut types.Value
+	}{
+		{"zero reserve in", zero, one},
+		{"zero reserve out", one, zero},
+		{"negative reserve in", eth.MustNewEth("-1"), one},
+		{"zero reserves", zero, zero},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := GetAmountOut(one, tt.reserveIn, tt.reserveOut, 30); !errors.Is(err, ErrInsufficientLiquidity) {
+				t.Errorf("GetAmountOut() error = %v, want ErrInsufficientLiquidity", err)
+			}
+			if _, err := PriceImpact(one, tt.reserveIn, tt.reserveOut, 30); !errors.Is(err, ErrInsufficientLiquidity) {
+				t.Errorf("PriceImpact() error = %v, want ErrInsufficientLiquidity", err)
+			}
+		})
+	}
+}
+
+func TestGetAmountOutMismatch(t *testing.T) {
+	bridged := types.NewCoinValueFromCoins[bridgedDefinition](decimal.NewFromInt(100))
+	_, err := GetAmountOut(eth.MustNewEth("1"), bridged, eth.MustNewEth("200"), 30)
+	var mismatch *types.MismatchError
+	if !errors.As(err, &mismatch) {
+		t.Fatalf("GetAmountOut() error = %v, want a *types.MismatchError", err)
+	}
+	if mismatch.Op != "swap" || mismatch.Left != "ETH" || mismatch.Right != "bridge/ETH" {
+		t.Errorf("GetAmountOut() error = %+v, want swap of ETH and bridge/ETH", mismatch)
+	}
+}
+
+func TestPriceImpact(t *testing.T) {
+	tests := []struct {
+		name                            string
+		amountIn, reserveIn, reserveOut types.Value
+		feeBps                          int
+		want                            string
+	}{
+		{"with fee", eth.MustNewEth("1"), eth.MustNewEth("100"), eth.MustNewEth("200"), 30, "0.012841965602938702"},
+		{"small amount without fee", eth.NewEthFromWei(big.NewInt(1e12)), eth.MustNewEth("1000000"), eth.MustNewEth("1000000"), 0, "0.000000000001"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PriceImpact(tt.amountIn, tt.reserveIn, tt.reserveOut, tt.feeBps)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !got.Equal(decimal.RequireFromString(tt.want)) {
+				t.Errorf("PriceImpact() = %s, want %s", got, tt.want)
+			}
+			if got.IsNegative() || !got.LessThan(decimal.NewFromInt(1)) {
+				t.Errorf("PriceImpact() = %s, want it in [0, 1)", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package eth
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// maxSupply bounds the amounts accepted by ParseEthAmount. Ether has no hard cap, so it is the circulating
+// supply of about 120 million ETH rounded up, leaving headroom for issuance.
+var maxSupply = decimal.NewFromInt(130_000_000)
+
+// ErrInvalidAmount is returned by ParseEthAmount for an amount that can't be accepted.
+var ErrInvalidAmount = errors.New("invalid amount")
+
+var (
+	// ErrAmountFormat is returned by ParseEthAmount for a string that isn't a decimal number.
+	ErrAmountFormat = fmt.Errorf("%w: not a decimal number", ErrInvalidAmount)
+	// ErrAmountNegative is returned by ParseEthAmount for a negative amount.
+	ErrAmountNegative = fmt.Errorf("%w: negative", ErrInvalidAmount)
+	// ErrAmountSupply is returned by ParseEthAmount for an amount exceeding the supply of Ether.
+	ErrAmountSupply = fmt.Errorf("%w: exceeds the supply of ETH", ErrInvalidAmount)
+	// ErrAmountPrecision is returned by ParseEthAmount for an amount with more than 18 fractional digits,
+	// which can't be represented in wei.
+	ErrAmountPrecision = fmt.Errorf("%w: more than 18 decimals", ErrInvalidAmount)
+)
+
+// ParseEthAmount parses a decimal string amount of ether entered by a user, e.g. "1.5".
+//
+// Unlike NewEthFromString, it only accepts amounts that can actually be sent: it rejects negative amounts and
+// amounts exceeding the supply of Ether.
+//
+// Parameters:
+// - s: the amount in ether.
+//
+// Returns:
+// - *Eth: the parsed amount.
+// - error: ErrAmountFormat, ErrAmountNegative, ErrAmountSupply or ErrAmountPrecision, all wrapping
+// ErrInvalidAmount, if the amount isn't valid.
+func ParseEthAmount(s string) (*Eth, error) {
+	ether, err := decimal.NewFromString(s)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q", ErrAmountFormat, s)
+	}
+	if ether.Sign() < 0 {
+		return nil, fmt.Errorf("%w: %s", ErrAmountNegative, s)
+	}
+	if ether.GreaterThan(maxSupply) {
+		return nil, fmt.Errorf("%w: %s", ErrAmountSupply, s)
+	}
+	eth, err := NewEthExact(ether)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrAmountPrecision, s)
+	}
+	return eth, nil
+}
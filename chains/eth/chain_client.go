@@ -0,0 +1,128 @@
+package eth
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// ClientOptions configures a ChainClient.
+type ClientOptions struct {
+	// Timeout bounds connecting and every call made with a context without deadline.
+	// Zero means the default of 30 seconds.
+	Timeout time.Duration
+
+	// Retries is the number of times read calls failing with a transient error are retried.
+	Retries int
+}
+
+// DefaultClientOptions returns the recommended options for a ChainClient.
+func DefaultClientOptions() ClientOptions {
+	return ClientOptions{
+		Timeout: defaultDialTimeout,
+		Retries: DefaultRetryPolicy().MaxAttempts - 1,
+	}
+}
+
+// ChainClient is an Ethereum client applying a default timeout to every call,
+// so that a hung node can't block callers passing a context without deadline forever.
+type ChainClient struct {
+	client  *ethclient.Client
+	timeout time.Duration
+}
+
+// NewChainClient connects to the RPC endpoint at rpcURL, see NewChainClientCtx.
+//
+// Deprecated: use NewChainClientCtx, which can be canceled and given a deadline.
+func NewChainClient(rpcURL string, opts ClientOptions) (*ChainClient, error) {
+	return NewChainClientCtx(context.Background(), rpcURL, opts)
+}
+
+// NewChainClientCtx connects to the RPC endpoint at rpcURL, see Dial.
+//
+// Connecting is bound to ctx as well as to the timeout of the options, whichever ends first.
+//
+// Parameters:
+// - ctx: the context of the connection, not retained by the client.
+// - rpcURL: the URL of the RPC endpoint.
+// - opts: the options of the client.
+//
+// Returns:
+// - *ChainClient: the connected client.
+// - error: a *DialError describing why the endpoint can't be used.
+func NewChainClientCtx(ctx context.Context, rpcURL string, opts ClientOptions) (*ChainClient, error) {
+	timeout := opts.Timeout
+	if timeout <= 0 {
+		timeout = defaultDialTimeout
+	}
+
+	client, err := Dial(ctx, rpcURL,
+		WithTimeout(timeout),
+		WithRetries(opts.Retries, defaultBackoff),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ChainClient{
+		client:  client,
+		timeout: timeout,
+	}, nil
+}
+
+// Client returns the underlying client, whose calls aren't bounded by the default timeout.
+func (c *ChainClient) Client() *ethclient.Client {
+	return c.client
+}
+
+// Close closes the underlying connection.
+func (c *ChainClient) Close() {
+	c.client.Close()
+}
+
+// withTimeout applies the default timeout to ctx if it has no deadline.
+func (c *ChainClient) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, c.timeout)
+}
+
+// ChainID returns the chain ID of the network.
+func (c *ChainClient) ChainID(ctx context.Context) (*big.Int, error) {
+	ctx, cancel := c.withTimeout(ctx)
+	defer cancel()
+	return c.client.ChainID(ctx)
+}
+
+// CodeAt returns the code of the account at the given block, the latest block if nil.
+func (c *ChainClient) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+	ctx, cancel := c.withTimeout(ctx)
+	defer cancel()
+	return c.client.CodeAt(ctx, account, blockNumber)
+}
+
+// BalanceAt returns the balance of the account at the given block, the latest block if nil.
+func (c *ChainClient) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	ctx, cancel := c.withTimeout(ctx)
+	defer cancel()
+	return c.client.BalanceAt(ctx, account, blockNumber)
+}
+
+// CallContract executes a message call at the given block, the latest block if nil.
+func (c *ChainClient) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	ctx, cancel := c.withTimeout(ctx)
+	defer cancel()
+	return c.client.CallContract(ctx, msg, blockNumber)
+}
+
+// IsSmartContract checks if the address is a smart contract, see IsSmartContractCtx.
+func (c *ChainClient) IsSmartContract(ctx context.Context, address string) (bool, error) {
+	ctx, cancel := c.withTimeout(ctx)
+	defer cancel()
+	return IsSmartContractCtx(ctx, address, c.client)
+}
@@ -0,0 +1,191 @@
+package eth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const (
+	defaultDialTimeout = 30 * time.Second
+	defaultBackoff     = 500 * time.Millisecond
+)
+
+// Option configures the client created by Dial.
+type Option func(*dialOptions)
+
+type dialOptions struct {
+	timeout time.Duration
+	retry   RetryPolicy
+	headers http.Header
+}
+
+// WithTimeout sets the timeout applied to connecting and to each attempt of an HTTP request.
+func WithTimeout(timeout time.Duration) Option {
+	return func(o *dialOptions) {
+		o.timeout = timeout
+	}
+}
+
+// WithRetries sets how many times connecting and read calls are retried after a transient failure,
+// waiting about backoff before the first retry and doubling the wait after each one.
+func WithRetries(retries int, backoff time.Duration) Option {
+	return func(o *dialOptions) {
+		o.retry.MaxAttempts = retries + 1
+		o.retry.BaseDelay = backoff
+	}
+}
+
+// WithRetryPolicy sets the policy used to retry connecting and read calls after a transient failure.
+//
+// Only calls to read methods over HTTP are retried, see RetryPolicy.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(o *dialOptions) {
+		o.retry = policy
+	}
+}
+
+// WithHeader adds a header sent with every request, e.g. an API key for authenticated RPC providers.
+func WithHeader(key, value string) Option {
+	return func(o *dialOptions) {
+		o.headers.Add(key, value)
+	}
+}
+
+// DialErrorKind classifies the failures of Dial.
+type DialErrorKind int
+
+const (
+	// DialErrorUnknown is a failure that couldn't be classified.
+	DialErrorUnknown DialErrorKind = iota
+	// DialErrorDNS is a failure to resolve the host of the RPC endpoint.
+	DialErrorDNS
+	// DialErrorConnect is a failure to reach the RPC endpoint.
+	DialErrorConnect
+	// DialErrorAuth is a rejection of the credentials by the RPC endpoint.
+	DialErrorAuth
+)
+
+func (k DialErrorKind) String() string {
+	switch k {
+	case DialErrorDNS:
+		return "dns"
+	case DialErrorConnect:
+		return "connect"
+	case DialErrorAuth:
+		return "auth"
+	default:
+		return "unknown"
+	}
+}
+
+// DialError is returned by Dial when the RPC endpoint can't be used.
+type DialError struct {
+	Kind DialErrorKind
+	URL  string
+	Err  error
+}
+
+func (e *DialError) Error() string {
+	return fmt.Sprintf("failed to dial %s (%s): %v", e.URL, e.Kind, e.Err)
+}
+
+func (e *DialError) Unwrap() error {
+	return e.Err
+}
+
+// Dial connects to the RPC endpoint at rawurl and returns a client for it.
+//
+// Since HTTP endpoints are connectionless, the endpoint is probed with an eth_chainId call,
+// so that unreachable endpoints and rejected credentials are reported here rather than on first use.
+// Failures are retried according to the retry policy, except for authentication failures.
+//
+// Over HTTP, read calls made through the client (e.g. eth_getCode, eth_getBalance, eth_call)
+// are retried as well when they fail with a transient error such as a timeout or a 429 status,
+// with exponential backoff and jitter, as long as the context deadline allows it.
+// Calls that change state are never retried.
+//
+// Returns:
+// - *ethclient.Client: the connected client.
+// - error: a *DialError describing why the endpoint can't be used.
+func Dial(ctx context.Context, rawurl string, opts ...Option) (*ethclient.Client, error) {
+	o := dialOptions{
+		timeout: defaultDialTimeout,
+		retry:   DefaultRetryPolicy(),
+		headers: http.Header{},
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	for attempt := 1; ; attempt++ {
+		client, err := dial(ctx, rawurl, &o)
+		if err == nil {
+			return ethclient.NewClient(client), nil
+		}
+		if err.Kind == DialErrorAuth || attempt >= o.retry.MaxAttempts {
+			return nil, err
+		}
+		delay, ok := o.retry.next(ctx, attempt-1, 0)
+		if !ok || !sleep(ctx, delay) {
+			return nil, err
+		}
+	}
+}
+
+func dial(ctx context.Context, rawurl string, o *dialOptions) (*rpc.Client, *DialError) {
+	ctx, cancel := context.WithTimeout(ctx, o.timeout)
+	defer cancel()
+
+	client, err := rpc.DialOptions(ctx, rawurl,
+		rpc.WithHeaders(o.headers),
+		rpc.WithHTTPClient(&http.Client{
+			Transport: &retryTransport{
+				base:    http.DefaultTransport,
+				policy:  o.retry,
+				timeout: o.timeout,
+			},
+		}),
+	)
+	if err != nil {
+		return nil, &DialError{Kind: classifyDialError(err), URL: rawurl, Err: err}
+	}
+
+	var chainID string
+	if err := client.CallContext(ctx, &chainID, "eth_chainId"); err != nil {
+		// a JSON-RPC error means the endpoint was reached and accepted the request
+		var rpcErr rpc.Error
+		if !errors.As(err, &rpcErr) {
+			client.Close()
+			return nil, &DialError{Kind: classifyDialError(err), URL: rawurl, Err: err}
+		}
+	}
+	return client, nil
+}
+
+func classifyDialError(err error) DialErrorKind {
+	var httpErr rpc.HTTPError
+	if errors.As(err, &httpErr) {
+		if httpErr.StatusCode == http.StatusUnauthorized || httpErr.StatusCode == http.StatusForbidden {
+			return DialErrorAuth
+		}
+		return DialErrorUnknown
+	}
+
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return DialErrorDNS
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) || errors.Is(err, context.DeadlineExceeded) {
+		return DialErrorConnect
+	}
+	return DialErrorUnknown
+}
@@ -0,0 +1,194 @@
+package eth
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// rpcServer is a JSON-RPC endpoint counting the requests it receives.
+type rpcServer struct {
+	*httptest.Server
+	requests atomic.Int32
+	done     chan struct{}
+}
+
+// newRPCServer starts a server answering eth_chainId if chainID is set,
+// and handling the other requests with handler.
+func newRPCServer(t *testing.T, chainID bool, handler func(s *rpcServer, w http.ResponseWriter, r *http.Request)) *rpcServer {
+	t.Helper()
+	s := &rpcServer{done: make(chan struct{})}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.requests.Add(1)
+		// the server only notices that the client went away once the body is read
+		body, _ := io.ReadAll(r.Body)
+		if chainID && strings.Contains(string(body), `"eth_chainId"`) {
+			w.Header().Set("Content-Type", "application/json")
+			io.WriteString(w, `{"jsonrpc":"2.0","id":1,"result":"0x1"}`)
+			return
+		}
+		handler(s, w, r)
+	}))
+	t.Cleanup(s.Close)
+	// cleanups run last in first out, so the hanging handlers are released before the server is closed
+	t.Cleanup(func() { close(s.done) })
+	return s
+}
+
+// hang blocks until the request is canceled or the test ends, like a node that never answers.
+func (s *rpcServer) hang(_ http.ResponseWriter, r *http.Request) {
+	select {
+	case <-r.Context().Done():
+	case <-s.done:
+	}
+}
+
+func hangingServer(t *testing.T) *rpcServer {
+	return newRPCServer(t, false, (*rpcServer).hang)
+}
+
+// failingServer answers every request with a transient failure.
+func failingServer(t *testing.T) *rpcServer {
+	return newRPCServer(t, false, func(_ *rpcServer, w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+}
+
+// returnsWithin fails the test if f doesn't return within limit.
+func returnsWithin(t *testing.T, limit time.Duration, f func()) {
+	t.Helper()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		f()
+	}()
+	select {
+	case <-done:
+	case <-time.After(limit):
+		t.Fatalf("still running after %s", limit)
+	}
+}
+
+func TestDialReturnsWhenContextIsDone(t *testing.T) {
+	tests := []struct {
+		name string
+		ctx  func() (context.Context, context.CancelFunc)
+		want error
+	}{
+		{"canceled", func() (context.Context, context.CancelFunc) {
+			ctx, cancel := context.WithCancel(context.Background())
+			time.AfterFunc(50*time.Millisecond, cancel)
+			return ctx, cancel
+		}, context.Canceled},
+		{"deadline", func() (context.Context, context.CancelFunc) {
+			return context.WithTimeout(context.Background(), 50*time.Millisecond)
+		}, context.DeadlineExceeded},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := hangingServer(t)
+			ctx, cancel := tt.ctx()
+			defer cancel()
+
+			returnsWithin(t, 5*time.Second, func() {
+				_, err := Dial(ctx, server.URL, WithTimeout(time.Hour), WithRetries(3, time.Millisecond))
+				var dialErr *DialError
+				if !errors.As(err, &dialErr) {
+					t.Errorf("error = %v, want a *DialError", err)
+				}
+				if !errors.Is(err, tt.want) {
+					t.Errorf("error = %v, want %v", err, tt.want)
+				}
+			})
+		})
+	}
+}
+
+func TestDialStopsRetryingWhenContextIsDone(t *testing.T) {
+	server := failingServer(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(100*time.Millisecond, cancel)
+
+	returnsWithin(t, 5*time.Second, func() {
+		if _, err := Dial(ctx, server.URL, WithRetries(1000, 10*time.Millisecond)); err == nil {
+			t.Error("Dial() succeeded, want an error")
+		}
+	})
+	requests := server.requests.Load()
+	if requests < 2 {
+		t.Errorf("%d requests, want the failures to be retried", requests)
+	}
+	time.Sleep(100 * time.Millisecond)
+	if got := server.requests.Load(); got != requests {
+		t.Errorf("%d requests after Dial returned, want %d", got, requests)
+	}
+}
+
+func TestDialDoesNotRetryPastDeadline(t *testing.T) {
+	server := failingServer(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	// the first retry would only happen after the deadline
+	returnsWithin(t, 5*time.Second, func() {
+		if _, err := Dial(ctx, server.URL, WithRetries(3, time.Hour)); err == nil {
+			t.Error("Dial() succeeded, want an error")
+		}
+	})
+	if got := server.requests.Load(); got != 1 {
+		t.Errorf("%d requests, want 1", got)
+	}
+}
+
+func TestNewChainClientCtx(t *testing.T) {
+	t.Run("timeout", func(t *testing.T) {
+		server := hangingServer(t)
+		returnsWithin(t, 5*time.Second, func() {
+			_, err := NewChainClientCtx(context.Background(), server.URL, ClientOptions{Timeout: 50 * time.Millisecond})
+			var dialErr *DialError
+			if !errors.As(err, &dialErr) || dialErr.Kind != DialErrorConnect {
+				t.Errorf("error = %v, want a *DialError of kind %s", err, DialErrorConnect)
+			}
+		})
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		server := hangingServer(t)
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(50*time.Millisecond, cancel)
+		returnsWithin(t, 5*time.Second, func() {
+			_, err := NewChainClientCtx(ctx, server.URL, DefaultClientOptions())
+			if !errors.Is(err, context.Canceled) {
+				t.Errorf("error = %v, want context.Canceled", err)
+			}
+		})
+	})
+}
+
+func TestChainClientDefaultTimeout(t *testing.T) {
+	server := newRPCServer(t, true, (*rpcServer).hang)
+	client, err := NewChainClientCtx(context.Background(), server.URL, ClientOptions{Timeout: 50 * time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	chainID, err := client.ChainID(context.Background())
+	if err != nil || chainID.Int64() != 1 {
+		t.Fatalf("ChainID() = %v, %v, want 1", chainID, err)
+	}
+	returnsWithin(t, 5*time.Second, func() {
+		_, err := client.BalanceAt(context.Background(), common.Address{}, nil)
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("BalanceAt() error = %v, want context.DeadlineExceeded", err)
+		}
+	})
+}
@@ -0,0 +1,41 @@
+package eth
+
+import "github.com/ethereum/go-ethereum/crypto"
+
+// ContractAddress returns the address of a contract deployed with CREATE,
+// i.e. the last 20 bytes of keccak256(rlp([deployer, nonce])).
+//
+// Parameters:
+// - deployer: the address of the account or contract deploying the contract.
+// - nonce: the nonce of the deployer when deploying, the transaction nonce of an account
+// or the number of contracts a contract created, starting at 1.
+//
+// Returns:
+// - string: the checksummed address of the contract.
+// - error: an error if the deployer address is invalid.
+func ContractAddress(deployer string, nonce uint64) (string, error) {
+	addr, err := ParseAddress(deployer)
+	if err != nil {
+		return "", err
+	}
+	return crypto.CreateAddress(addr, nonce).Hex(), nil
+}
+
+// Create2Address returns the address of a contract deployed with CREATE2, as specified by EIP-1014,
+// i.e. the last 20 bytes of keccak256(0xff ++ deployer ++ salt ++ initCodeHash).
+//
+// Parameters:
+// - deployer: the address of the contract executing CREATE2, e.g. a factory.
+// - salt: the salt passed to CREATE2.
+// - initCodeHash: the Keccak-256 hash of the init code of the contract.
+//
+// Returns:
+// - string: the checksummed address of the contract.
+// - error: an error if the deployer address is invalid.
+func Create2Address(deployer string, salt [32]byte, initCodeHash [32]byte) (string, error) {
+	addr, err := ParseAddress(deployer)
+	if err != nil {
+		return "", err
+	}
+	return crypto.CreateAddress2(addr, salt, initCodeHash[:]).Hex(), nil
+}
@@ -0,0 +1,78 @@
+package eth
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// checkAddress checks that got is the checksummed form of want.
+func checkAddress(t *testing.T, got, want string) {
+	t.Helper()
+	if !strings.EqualFold(got, want) {
+		t.Errorf("address = %s, want %s", got, want)
+	}
+	if checksummed, err := ChecksumAddress(got); err != nil || got != checksummed {
+		t.Errorf("address %s isn't checksummed, want %s", got, checksummed)
+	}
+}
+
+func TestContractAddress(t *testing.T) {
+	const deployer = "0x6ac7ea33f8831ea9dcc53393aaa88b25a785dbf0"
+	tests := []struct {
+		nonce uint64
+		want  string
+	}{
+		{0, "0xcd234a471b72ba2f1ccf0a70fcaba648a5eecd8d"},
+		{1, "0x343c43a37d37dff08ae8c4a11544c718abb4fcf8"},
+		{2, "0xf778b86fa74e846c4f0a1fbd1335fe81c00a0c91"},
+		{3, "0xfffd933a0bc612844eaf0c6fe3e5b8e9b6c1d19c"},
+		// nonces not encoded in a single RLP byte
+		{128, "0x08e190dcb7b73f5fcdabb43e102215c83659a76d"},
+		{1 << 32, "0xf4bf328880432064068338f915c49f817dc4ce18"},
+	}
+	for _, tt := range tests {
+		got, err := ContractAddress(deployer, tt.nonce)
+		if err != nil {
+			t.Fatalf("ContractAddress(%d): %v", tt.nonce, err)
+		}
+		checkAddress(t, got, tt.want)
+	}
+
+	if _, err := ContractAddress("0x6ac7ea33", 0); err == nil {
+		t.Errorf("ContractAddress() of an invalid deployer succeeded, want an error")
+	}
+}
+
+func TestCreate2Address(t *testing.T) {
+	// the examples of EIP-1014
+	tests := []struct {
+		deployer string
+		salt     string
+		initCode string
+		want     string
+	}{
+		{"0x0000000000000000000000000000000000000000", "0x00", "0x00", "0x4D1A2e2bB4F88F0250f26Ffff098B0b30B26BF38"},
+		{"0xdeadbeef00000000000000000000000000000000", "0x00", "0x00", "0xB928f69Bb1D91Cd65274e3c79d8986362984fDA3"},
+		{"0xdeadbeef00000000000000000000000000000000", "0x000000000000000000000000feed000000000000000000000000000000000000", "0x00", "0xD04116cDd17beBE565EB2422F2497E06cC1C9833"},
+		{"0x0000000000000000000000000000000000000000", "0x00", "0xdeadbeef", "0x70f2b2914A2a4b783FaEFb75f459A580616Fcb5e"},
+		{"0x00000000000000000000000000000000deadbeef", "0xcafebabe", "0xdeadbeef", "0x60f3f640a8508fC6a86d45DF051962668E1e8AC7"},
+		{"0x00000000000000000000000000000000deadbeef", "0xcafebabe", "0x" + strings.Repeat("deadbeef", 11), "0x1d8bfDC5D46DC4f61D6b6115972536eBE6A8854C"},
+		{"0x0000000000000000000000000000000000000000", "0x00", "0x", "0xE33C0C7F7df4809055C3ebA6c09CFe4BaF1BD9e0"},
+	}
+	for i, tt := range tests {
+		got, err := Create2Address(tt.deployer, common.HexToHash(tt.salt), crypto.Keccak256Hash(common.FromHex(tt.initCode)))
+		if err != nil {
+			t.Fatalf("Create2Address() of example %d: %v", i, err)
+		}
+		if got != tt.want {
+			t.Errorf("Create2Address() of example %d = %s, want %s", i, got, tt.want)
+		}
+	}
+
+	if _, err := Create2Address("deadbeef", [32]byte{}, [32]byte{}); err == nil {
+		t.Errorf("Create2Address() of an invalid deployer succeeded, want an error")
+	}
+}
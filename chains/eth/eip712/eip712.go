@@ -0,0 +1,525 @@
+// Package eip712 hashes EIP-712 typed structured data and recovers its signers.
+package eip712
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"math/big"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/airsigner/libcrypto/chains/eth"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	// ErrInvalidTypes is returned when the type definitions are invalid, e.g. reference a type that doesn't exist.
+	ErrInvalidTypes = errors.New("invalid eip712 types")
+
+	// ErrInvalidMessage is returned when a message doesn't match its type definition.
+	ErrInvalidMessage = errors.New("invalid eip712 message")
+
+	typeNameRegexp = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+	arrayRegexp    = regexp.MustCompile(`^(.+)\[([0-9]*)\]$`)
+)
+
+const (
+	domainType = "EIP712Domain"
+	wordLen    = 32
+
+	// maxSafeInteger is the largest integer n such that n and n+1 are exactly represented as float64, 2^53 - 1
+	maxSafeInteger = 1<<53 - 1
+)
+
+// Field is a member of a struct type.
+type Field struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// Types are the struct type definitions, by type name.
+//
+// Field types are either atomic (bool, address, uint8 to uint256, int8 to int256, bytes1 to bytes32),
+// dynamic (string, bytes), one of the struct types, or an array of any of them, e.g. "Person[]" or "uint256[2]".
+type Types map[string][]Field
+
+// Domain is the EIP-712 domain separating the signatures of an application from the others.
+//
+// Only the set fields are part of the domain, in the order of the struct.
+type Domain struct {
+	Name              string
+	Version           string
+	ChainID           *big.Int
+	VerifyingContract string
+	Salt              *common.Hash
+}
+
+// TypedData is a typed structured data message and its definition, as passed to eth_signTypedData_v4.
+type TypedData struct {
+	Types       Types
+	PrimaryType string
+	Domain      Domain
+	Message     map[string]any
+}
+
+// Hash returns the EIP-712 hash of the typed data, see HashTypedData.
+func (d TypedData) Hash() (common.Hash, error) {
+	return HashTypedData(d.Domain, d.Types, d.PrimaryType, d.Message)
+}
+
+// HashTypedData returns the EIP-712 hash of a message, i.e. the hash signed with eth_signTypedData_v4:
+// keccak256("\x19\x01" || domainSeparator || hashStruct(message)).
+//
+// The EIP712Domain type is derived from the fields set in the domain, a definition of it in types is ignored.
+//
+// Parameters:
+// - domain: the domain of the message.
+// - types: the definitions of the primary type and of all the struct types it references.
+// - primaryType: the type of the message.
+// - message: the message, by field name. Integers are *big.Int, Go integers, json.Number, decimal or
+// 0x prefixed hex strings, or float64 holding integers of at most 2^53 - 1 in absolute value; addresses, bytes and bytesN are 0x prefixed hex strings, common.Address or []byte;
+// structs are map[string]any and arrays are []any or any slice of the element values.
+//
+// Returns:
+// - common.Hash: the hash of the message.
+// - error: an error wrapping ErrInvalidTypes if the types are invalid or ErrInvalidMessage if the message
+// doesn't match them.
+func HashTypedData(domain Domain, types Types, primaryType string, message map[string]any) (common.Hash, error) {
+	fields, _ := domain.fields()
+	types = maps.Clone(types)
+	types[domainType] = fields
+
+	if err := types.validate(); err != nil {
+		return common.Hash{}, err
+	}
+	if primaryType == domainType {
+		return common.Hash{}, fmt.Errorf("%w: primary type can't be %s", ErrInvalidTypes, domainType)
+	}
+	if _, ok := types[primaryType]; !ok {
+		return common.Hash{}, fmt.Errorf("%w: undefined primary type %q", ErrInvalidTypes, primaryType)
+	}
+
+	separator, err := domain.DomainSeparator()
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("domain: %w", err)
+	}
+	hash, err := types.hashStruct(primaryType, message)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return crypto.Keccak256Hash([]byte{0x19, 0x01}, separator[:], hash[:]), nil
+}
+
+// RecoverTypedDataSigner returns the address that signed a message with eth_signTypedData_v4.
+//
+// Parameters:
+// - data: the typed data that was signed.
+// - sig: the 65 bytes [R || S || V] signature, V being the recovery ID either as 0/1 or as 27/28.
+//
+// Returns:
+// - eth.Address: the address of the signer.
+// - error: an error if the typed data can't be hashed, see HashTypedData, or the signature is invalid,
+// see eth.RecoverHashSigner.
+func RecoverTypedDataSigner(data TypedData, sig []byte) (eth.Address, error) {
+	hash, err := data.Hash()
+	if err != nil {
+		return eth.Address{}, err
+	}
+	return eth.RecoverHashSigner(hash, sig)
+}
+
+// DomainSeparator returns the hash of the domain, as returned by the DOMAIN_SEPARATOR() of many contracts.
+//
+// It fails if the verifying contract isn't a valid address.
+func (d Domain) DomainSeparator() (common.Hash, error) {
+	fields, values := d.fields()
+	return Types{domainType: fields}.hashStruct(domainType, values)
+}
+
+// fields returns the EIP712Domain type and value of the domain.
+func (d Domain) fields() ([]Field, map[string]any) {
+	fields := []Field{}
+	values := map[string]any{}
+	if d.Name != "" {
+		fields = append(fields, Field{Name: "name", Type: "string"})
+		values["name"] = d.Name
+	}
+	if d.Version != "" {
+		fields = append(fields, Field{Name: "version", Type: "string"})
+		values["version"] = d.Version
+	}
+	if d.ChainID != nil {
+		fields = append(fields, Field{Name: "chainId", Type: "uint256"})
+		values["chainId"] = d.ChainID
+	}
+	if d.VerifyingContract != "" {
+		fields = append(fields, Field{Name: "verifyingContract", Type: "address"})
+		values["verifyingContract"] = d.VerifyingContract
+	}
+	if d.Salt != nil {
+		fields = append(fields, Field{Name: "salt", Type: "bytes32"})
+		values["salt"] = d.Salt.Bytes()
+	}
+	return fields, values
+}
+
+// validate checks the type names and that every field type is valid or defined.
+func (t Types) validate() error {
+	for name, fields := range t {
+		if !typeNameRegexp.MatchString(name) {
+			return fmt.Errorf("%w: invalid type name %q", ErrInvalidTypes, name)
+		}
+		if isAtomic(name) || name == "string" || name == "bytes" {
+			return fmt.Errorf("%w: type name %q is reserved", ErrInvalidTypes, name)
+		}
+
+		seen := make(map[string]bool, len(fields))
+		for _, field := range fields {
+			if field.Name == "" || seen[field.Name] {
+				return fmt.Errorf("%w: invalid or duplicate field name %q in %s", ErrInvalidTypes, field.Name, name)
+			}
+			seen[field.Name] = true
+
+			base := baseType(field.Type)
+			if _, ok := t[base]; !ok && !isAtomic(base) && base != "string" && base != "bytes" {
+				return fmt.Errorf("%w: undefined type %q of %s.%s", ErrInvalidTypes, field.Type, name, field.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// baseType returns the type of the elements of a possibly nested array type, the type itself if it isn't an array.
+func baseType(typ string) string {
+	for {
+		m := arrayRegexp.FindStringSubmatch(typ)
+		if m == nil {
+			return typ
+		}
+		typ = m[1]
+	}
+}
+
+// isAtomic checks if the type is an atomic type, i.e. encoded as its 32 bytes value.
+func isAtomic(typ string) bool {
+	switch typ {
+	case "bool", "address":
+		return true
+	}
+	if bits, ok := intBits(typ); ok {
+		return bits > 0
+	}
+	if size, ok := bytesSize(typ); ok {
+		return size > 0
+	}
+	return false
+}
+
+// intBits returns the number of bits of an uintN or intN type, zero if N is invalid.
+func intBits(typ string) (int, bool) {
+	digits, ok := strings.CutPrefix(strings.TrimPrefix(typ, "u"), "int")
+	if !ok || digits == "" {
+		return 0, false
+	}
+	bits, err := strconv.Atoi(digits)
+	if err != nil || bits <= 0 || bits > 256 || bits%8 != 0 || digits[0] == '0' {
+		return 0, true
+	}
+	return bits, true
+}
+
+// bytesSize returns the size of a bytesN type, zero if N is invalid.
+func bytesSize(typ string) (int, bool) {
+	digits, ok := strings.CutPrefix(typ, "bytes")
+	if !ok || digits == "" {
+		return 0, false
+	}
+	size, err := strconv.Atoi(digits)
+	if err != nil || size <= 0 || size > wordLen || digits[0] == '0' {
+		return 0, true
+	}
+	return size, true
+}
+
+// encodeType returns the encoding of a struct type: the type itself followed by the struct types it references,
+// sorted by name, e.g. "Mail(Person from,Person to,string contents)Person(string name,address wallet)".
+func (t Types) encodeType(name string) string {
+	deps := map[string]bool{}
+	t.dependencies(name, deps)
+	delete(deps, name)
+	sorted := make([]string, 0, len(deps))
+	for dep := range deps {
+		sorted = append(sorted, dep)
+	}
+	slices.Sort(sorted)
+
+	var b strings.Builder
+	for _, typ := range append([]string{name}, sorted...) {
+		b.WriteString(typ)
+		b.WriteByte('(')
+		for i, field := range t[typ] {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			b.WriteString(field.Type)
+			b.WriteByte(' ')
+			b.WriteString(field.Name)
+		}
+		b.WriteByte(')')
+	}
+	return b.String()
+}
+
+// dependencies adds the struct type and the struct types it references, directly or not, to deps.
+func (t Types) dependencies(name string, deps map[string]bool) {
+	if deps[name] {
+		return
+	}
+	deps[name] = true
+	for _, field := range t[name] {
+		if base := baseType(field.Type); t[base] != nil {
+			t.dependencies(base, deps)
+		}
+	}
+}
+
+// hashStruct returns keccak256(typeHash || encodeData(value)).
+func (t Types) hashStruct(name string, value map[string]any) (common.Hash, error) {
+	if value == nil {
+		return common.Hash{}, fmt.Errorf("%w: missing %s value", ErrInvalidMessage, name)
+	}
+
+	typeHash := crypto.Keccak256Hash([]byte(t.encodeType(name)))
+	enc := make([]byte, 0, (len(t[name])+1)*wordLen)
+	enc = append(enc, typeHash[:]...)
+	for _, field := range t[name] {
+		v, ok := value[field.Name]
+		if !ok {
+			return common.Hash{}, fmt.Errorf("%w: missing field %s.%s", ErrInvalidMessage, name, field.Name)
+		}
+		word, err := t.encodeValue(field.Type, v)
+		if err != nil {
+			return common.Hash{}, fmt.Errorf("%s.%s: %w", name, field.Name, err)
+		}
+		enc = append(enc, word...)
+	}
+	return crypto.Keccak256Hash(enc), nil
+}
+
+// encodeValue returns the 32 bytes encoding of a value: its value for atomic types
+// and the hash of its encoding for dynamic types, structs and arrays.
+func (t Types) encodeValue(typ string, v any) ([]byte, error) {
+	if m := arrayRegexp.FindStringSubmatch(typ); m != nil {
+		return t.encodeArray(m[1], m[2], v)
+	}
+	if _, ok := t[typ]; ok {
+		value, ok := v.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("%w: %T isn't a %s struct", ErrInvalidMessage, v, typ)
+		}
+		hash, err := t.hashStruct(typ, value)
+		return hash[:], err
+	}
+
+	switch typ {
+	case "string":
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("%w: %T isn't a string", ErrInvalidMessage, v)
+		}
+		return crypto.Keccak256([]byte(s)), nil
+	case "bytes":
+		b, err := toBytes(v)
+		if err != nil {
+			return nil, err
+		}
+		return crypto.Keccak256(b), nil
+	case "bool":
+		b, ok := v.(bool)
+		if !ok {
+			return nil, fmt.Errorf("%w: %T isn't a bool", ErrInvalidMessage, v)
+		}
+		word := make([]byte, wordLen)
+		if b {
+			word[wordLen-1] = 1
+		}
+		return word, nil
+	case "address":
+		return encodeAddress(v)
+	}
+
+	if size, ok := bytesSize(typ); ok {
+		b, err := toBytes(v)
+		if err != nil {
+			return nil, err
+		}
+		if len(b) > size {
+			return nil, fmt.Errorf("%w: %d bytes don't fit in %s", ErrInvalidMessage, len(b), typ)
+		}
+		return common.RightPadBytes(b, wordLen), nil
+	}
+	bits, _ := intBits(typ)
+	return encodeInt(v, bits, !strings.HasPrefix(typ, "u"))
+}
+
+// encodeArray returns the hash of the concatenated encodings of the elements.
+func (t Types) encodeArray(elemType, length string, v any) ([]byte, error) {
+	elems, err := toSlice(v)
+	if err != nil {
+		return nil, err
+	}
+	if length != "" && strconv.Itoa(len(elems)) != length {
+		return nil, fmt.Errorf("%w: got %d elements, want %s", ErrInvalidMessage, len(elems), length)
+	}
+
+	enc := make([]byte, 0, len(elems)*wordLen)
+	for i, elem := range elems {
+		word, err := t.encodeValue(elemType, elem)
+		if err != nil {
+			return nil, fmt.Errorf("[%d]: %w", i, err)
+		}
+		enc = append(enc, word...)
+	}
+	return crypto.Keccak256(enc), nil
+}
+
+func toSlice(v any) ([]any, error) {
+	switch s := v.(type) {
+	case []any:
+		return s, nil
+	case []map[string]any:
+		return toAny(s), nil
+	case []string:
+		return toAny(s), nil
+	case []*big.Int:
+		return toAny(s), nil
+	case []bool:
+		return toAny(s), nil
+	case [][]byte:
+		return toAny(s), nil
+	case []common.Address:
+		return toAny(s), nil
+	}
+	return nil, fmt.Errorf("%w: %T isn't an array", ErrInvalidMessage, v)
+}
+
+func toAny[T any](s []T) []any {
+	elems := make([]any, len(s))
+	for i, e := range s {
+		elems[i] = e
+	}
+	return elems
+}
+
+func toBytes(v any) ([]byte, error) {
+	switch b := v.(type) {
+	case []byte:
+		return b, nil
+	case string:
+		decoded, err := hexutil.Decode(b)
+		if err != nil {
+			return nil, fmt.Errorf("%w: bytes %q: %w", ErrInvalidMessage, b, err)
+		}
+		return decoded, nil
+	}
+	return nil, fmt.Errorf("%w: %T isn't bytes", ErrInvalidMessage, v)
+}
+
+func encodeAddress(v any) ([]byte, error) {
+	var addr common.Address
+	switch a := v.(type) {
+	case common.Address:
+		addr = a
+	case string:
+		parsed, err := eth.ParseAddress(a)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidMessage, err)
+		}
+		addr = parsed
+	case []byte:
+		if len(a) != common.AddressLength {
+			return nil, fmt.Errorf("%w: address of %d bytes", ErrInvalidMessage, len(a))
+		}
+		addr = common.BytesToAddress(a)
+	default:
+		return nil, fmt.Errorf("%w: %T isn't an address", ErrInvalidMessage, v)
+	}
+	return common.LeftPadBytes(addr[:], wordLen), nil
+}
+
+// encodeInt returns the 32 bytes two's complement encoding of an integer, checking it fits in the given bits.
+func encodeInt(v any, bits int, signed bool) ([]byte, error) {
+	n, err := toBigInt(v)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := new(big.Int).Lsh(big.NewInt(1), uint(bits))
+	minimum := new(big.Int)
+	if signed {
+		limit.Rsh(limit, 1)
+		minimum.Neg(limit)
+	}
+	if n.Cmp(minimum) < 0 || n.Cmp(limit) >= 0 {
+		return nil, fmt.Errorf("%w: %s out of range of %d bits", ErrInvalidMessage, n, bits)
+	}
+
+	if n.Sign() < 0 {
+		n = new(big.Int).Add(n, new(big.Int).Lsh(big.NewInt(1), 8*wordLen))
+	}
+	return common.LeftPadBytes(n.Bytes(), wordLen), nil
+}
+
+func toBigInt(v any) (*big.Int, error) {
+	switch n := v.(type) {
+	case *big.Int:
+		if n == nil {
+			return nil, fmt.Errorf("%w: nil integer", ErrInvalidMessage)
+		}
+		return n, nil
+	case int:
+		return big.NewInt(int64(n)), nil
+	case int64:
+		return big.NewInt(n), nil
+	case int32:
+		return big.NewInt(int64(n)), nil
+	case uint:
+		return new(big.Int).SetUint64(uint64(n)), nil
+	case uint64:
+		return new(big.Int).SetUint64(n), nil
+	case uint32:
+		return new(big.Int).SetUint64(uint64(n)), nil
+	case float64:
+		// numbers decoded from JSON without json.Decoder.UseNumber, only exact if they are safe integers:
+		// larger numbers were rounded when decoded, so they must be given as json.Number or strings
+		if n != math.Trunc(n) || math.Abs(n) > maxSafeInteger {
+			return nil, fmt.Errorf("%w: %v isn't a safe integer, use json.Number or a string", ErrInvalidMessage, n)
+		}
+		return big.NewInt(int64(n)), nil
+	case json.Number:
+		return parseInt(string(n))
+	case string:
+		return parseInt(n)
+	}
+	return nil, fmt.Errorf("%w: %T isn't an integer", ErrInvalidMessage, v)
+}
+
+func parseInt(s string) (*big.Int, error) {
+	n, ok := new(big.Int), false
+	if hex, isHex := strings.CutPrefix(s, "0x"); isHex {
+		n, ok = n.SetString(hex, 16)
+	} else {
+		n, ok = n.SetString(s, 10)
+	}
+	if !ok {
+		return nil, fmt.Errorf("%w: invalid integer %q", ErrInvalidMessage, s)
+	}
+	return n, nil
+}
@@ -0,0 +1,152 @@
+package eip712
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// mail is the example of EIP-712.
+func mail() TypedData {
+	return TypedData{
+		Types: Types{
+			"Person": {{Name: "name", Type: "string"}, {Name: "wallet", Type: "address"}},
+			"Mail":   {{Name: "from", Type: "Person"}, {Name: "to", Type: "Person"}, {Name: "contents", Type: "string"}},
+		},
+		PrimaryType: "Mail",
+		Domain: Domain{
+			Name:              "Ether Mail",
+			Version:           "1",
+			ChainID:           big.NewInt(1),
+			VerifyingContract: "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC",
+		},
+		Message: map[string]any{
+			"from":     map[string]any{"name": "Cow", "wallet": "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826"},
+			"to":       map[string]any{"name": "Bob", "wallet": "0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB"},
+			"contents": "Hello, Bob!",
+		},
+	}
+}
+
+func TestMailExample(t *testing.T) {
+	data := mail()
+
+	separator, err := data.Domain.DomainSeparator()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "0xf2cee375fa42b42143804025fc449deafd50cc031ca257e0b194a650a912090f"; separator.Hex() != want {
+		t.Errorf("DomainSeparator() = %s, want %s", separator.Hex(), want)
+	}
+	if got, want := data.Types.encodeType("Mail"), "Mail(Person from,Person to,string contents)Person(string name,address wallet)"; got != want {
+		t.Errorf("encodeType(Mail) = %q, want %q", got, want)
+	}
+
+	hash, err := data.Hash()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "0xbe609aee343fb3c4b28e1df9e632fca64fcfaede20f02e86244efddf30957bd2"; hash.Hex() != want {
+		t.Errorf("Hash() = %s, want %s", hash.Hex(), want)
+	}
+
+	// signed by the key keccak256("cow")
+	sig := append(append(
+		common.FromHex("0x4355c47d63924e8a72e509b65029052eb6c299d53a04e167c5775fd466751c9d"),
+		common.FromHex("0x07299936d304c153f6443dfa05f40ff007d72911b6f72307f996231605b91562")...), 28)
+	signer, err := RecoverTypedDataSigner(data, sig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := crypto.PubkeyToAddress(cowKey(t).PublicKey); signer != want {
+		t.Errorf("RecoverTypedDataSigner() = %s, want %s", signer.Hex(), want.Hex())
+	}
+	if want := "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826"; signer.Hex() != want {
+		t.Errorf("RecoverTypedDataSigner() = %s, want %s", signer.Hex(), want)
+	}
+}
+
+func cowKey(t *testing.T) *ecdsa.PrivateKey {
+	t.Helper()
+	key, err := crypto.ToECDSA(crypto.Keccak256([]byte("cow")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func TestHashTypedDataFromJSON(t *testing.T) {
+	data := mail()
+	data.Types["Mail"] = append(data.Types["Mail"], Field{Name: "amount", Type: "uint256"})
+	want := func(amount any) common.Hash {
+		t.Helper()
+		data.Message["amount"] = amount
+		hash, err := data.Hash()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return hash
+	}(big.NewInt(1_000_000))
+
+	// the same amount decoded with and without json.Decoder.UseNumber
+	var withFloat, withNumber map[string]any
+	if err := json.Unmarshal([]byte(`{"amount": 1000000}`), &withFloat); err != nil {
+		t.Fatal(err)
+	}
+	d := json.NewDecoder(strings.NewReader(`{"amount": 1000000}`))
+	d.UseNumber()
+	if err := d.Decode(&withNumber); err != nil {
+		t.Fatal(err)
+	}
+	for _, amount := range []any{withFloat["amount"], withNumber["amount"], "1000000", "0xf4240", uint64(1_000_000)} {
+		data.Message["amount"] = amount
+		if got, err := data.Hash(); err != nil || got != want {
+			t.Errorf("Hash() with amount %#v = %s, %v, want %s", amount, got.Hex(), err, want.Hex())
+		}
+	}
+}
+
+func TestToBigIntFloat(t *testing.T) {
+	tests := []struct {
+		f     float64
+		valid bool
+	}{
+		{0, true},
+		{42, true},
+		{-42, true},
+		{1<<53 - 1, true},
+		{-(1<<53 - 1), true},
+
+		{1 << 53, false},
+		{-(1 << 53), false},
+		{1e30, false},
+		{1.5, false},
+		{-0.1, false},
+		{math.NaN(), false},
+		{math.Inf(1), false},
+		{math.Inf(-1), false},
+	}
+	for _, tt := range tests {
+		got, err := toBigInt(tt.f)
+		if !tt.valid {
+			if !errors.Is(err, ErrInvalidMessage) {
+				t.Errorf("toBigInt(%v) = %v, %v, want ErrInvalidMessage", tt.f, got, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("toBigInt(%v): %v", tt.f, err)
+			continue
+		}
+		if got.Cmp(big.NewInt(int64(tt.f))) != 0 {
+			t.Errorf("toBigInt(%v) = %s", tt.f, got)
+		}
+	}
+}
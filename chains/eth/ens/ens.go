@@ -0,0 +1,189 @@
+// Package ens resolves Ethereum Name Service names to addresses and back.
+package ens
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+
+	"github.com/airsigner/libcrypto/chains/eth"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"golang.org/x/net/idna"
+)
+
+var (
+	// ErrNotFound is returned when a name or an address has no record.
+	ErrNotFound = errors.New("ens record not found")
+
+	// ErrInvalidName is returned when a name can't be normalized.
+	ErrInvalidName = errors.New("invalid ens name")
+
+	// RegistryAddress is the address of the ENS registry, the same on mainnet and the main testnets.
+	RegistryAddress = common.HexToAddress("0x00000000000C2E074eC69A0dFb2997BA6C7d2e1e")
+
+	// function selectors of the registry and resolver interfaces
+	selectorResolver = []byte{0x01, 0x78, 0xb8, 0xbf} // resolver(bytes32)
+	selectorAddr     = []byte{0x3b, 0x3b, 0x57, 0xde} // addr(bytes32)
+	selectorName     = []byte{0x69, 0x1f, 0x34, 0x31} // name(bytes32)
+
+	profile = idna.New(
+		idna.MapForLookup(),
+		idna.Transitional(false),
+		idna.StrictDomainName(false),
+	)
+)
+
+const (
+	wordLen       = 32
+	reverseSuffix = ".addr.reverse"
+)
+
+// Normalize normalizes a name according to UTS-46, e.g. "Vitalik.ETH" becomes "vitalik.eth".
+//
+// Names with an empty label, e.g. "a..eth", are invalid.
+func Normalize(name string) (string, error) {
+	normalized, err := profile.ToUnicode(name)
+	if err != nil || normalized == "" || slices.Contains(strings.Split(normalized, "."), "") {
+		return "", fmt.Errorf("%w %q", ErrInvalidName, name)
+	}
+	return normalized, nil
+}
+
+// Namehash returns the EIP-137 namehash of a normalized name.
+func Namehash(name string) common.Hash {
+	var node common.Hash
+	if name == "" {
+		return node
+	}
+
+	labels := strings.Split(name, ".")
+	for i := len(labels) - 1; i >= 0; i-- {
+		node = crypto.Keccak256Hash(node[:], crypto.Keccak256([]byte(labels[i])))
+	}
+	return node
+}
+
+// Resolve returns the address a name resolves to.
+//
+// Parameters:
+// - ctx: the context of the calls.
+// - name: the name to resolve, normalized with Normalize.
+// - client: the client used to call the registry and the resolver, e.g. an *ethclient.Client.
+//
+// Returns:
+// - string: the EIP-55 checksummed address.
+// - error: ErrInvalidName if the name can't be normalized, ErrNotFound if it has no resolver or address,
+// or the error of the client.
+func Resolve(ctx context.Context, name string, client eth.ContractCaller) (string, error) {
+	normalized, err := Normalize(name)
+	if err != nil {
+		return "", err
+	}
+
+	node := Namehash(normalized)
+	out, err := callResolver(ctx, client, node, selectorAddr)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve %s: %w", normalized, err)
+	}
+	if len(out) < wordLen {
+		return "", fmt.Errorf("failed to resolve %s: unexpected result length %d", normalized, len(out))
+	}
+
+	addr := common.BytesToAddress(out[:wordLen])
+	if addr == (common.Address{}) {
+		return "", fmt.Errorf("%w: %s", ErrNotFound, normalized)
+	}
+	return addr.Hex(), nil
+}
+
+// ReverseResolve returns the primary name of an address.
+//
+// The name is only returned if it resolves back to the address, as anyone can claim any name
+// in the reverse record of their own address.
+//
+// Parameters:
+// - ctx: the context of the calls.
+// - address: the address to get the primary name of.
+// - client: the client used to call the registry and the resolvers, e.g. an *ethclient.Client.
+//
+// Returns:
+// - string: the primary name of the address.
+// - error: eth.ErrInvalidAddress if the address is invalid, ErrNotFound if it has no primary name
+// or if the name doesn't resolve to it, or the error of the client.
+func ReverseResolve(ctx context.Context, address string, client eth.ContractCaller) (string, error) {
+	addr, err := eth.ParseAddress(address)
+	if err != nil {
+		return "", err
+	}
+
+	node := Namehash(strings.ToLower(addr.Hex()[2:]) + reverseSuffix)
+	out, err := callResolver(ctx, client, node, selectorName)
+	if err != nil {
+		return "", fmt.Errorf("failed to reverse resolve %s: %w", addr.Hex(), err)
+	}
+	name, err := decodeString(out)
+	if err != nil {
+		return "", fmt.Errorf("failed to reverse resolve %s: %w", addr.Hex(), err)
+	}
+	if name == "" {
+		return "", fmt.Errorf("%w: %s", ErrNotFound, addr.Hex())
+	}
+
+	resolved, err := Resolve(ctx, name, client)
+	if errors.Is(err, ErrNotFound) || errors.Is(err, ErrInvalidName) || err == nil && resolved != addr.Hex() {
+		return "", fmt.Errorf("%w: %s claims %s which doesn't resolve to it", ErrNotFound, addr.Hex(), name)
+	}
+	if err != nil {
+		return "", err
+	}
+	return name, nil
+}
+
+// callResolver calls the resolver of node, as set in the registry, with the given selector and node as argument.
+func callResolver(ctx context.Context, client eth.ContractCaller, node common.Hash, selector []byte) ([]byte, error) {
+	out, err := call(ctx, client, RegistryAddress, callData(selectorResolver, node))
+	if err != nil {
+		return nil, err
+	}
+	if len(out) < wordLen {
+		return nil, fmt.Errorf("unexpected resolver result length %d", len(out))
+	}
+
+	resolver := common.BytesToAddress(out[:wordLen])
+	if resolver == (common.Address{}) {
+		return nil, ErrNotFound
+	}
+	return call(ctx, client, resolver, callData(selector, node))
+}
+
+func call(ctx context.Context, client eth.ContractCaller, to common.Address, data []byte) ([]byte, error) {
+	return client.CallContract(ctx, ethereum.CallMsg{To: &to, Data: data}, nil)
+}
+
+// callData returns the calldata of a call with the given selector and node as only argument.
+func callData(selector []byte, node common.Hash) []byte {
+	return append(append([]byte{}, selector...), node[:]...)
+}
+
+// decodeString decodes an ABI encoded string returned by a call.
+func decodeString(out []byte) (string, error) {
+	if len(out) < 2*wordLen {
+		return "", fmt.Errorf("unexpected result length %d", len(out))
+	}
+
+	offset := new(big.Int).SetBytes(out[:wordLen])
+	if !offset.IsUint64() || offset.Uint64() > uint64(len(out)-wordLen) {
+		return "", errors.New("invalid string offset")
+	}
+	start := offset.Uint64() + wordLen
+	length := new(big.Int).SetBytes(out[start-wordLen : start])
+	if !length.IsUint64() || length.Uint64() > uint64(len(out))-start {
+		return "", errors.New("invalid string length")
+	}
+	return string(out[start : start+length.Uint64()]), nil
+}
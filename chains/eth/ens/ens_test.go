@@ -0,0 +1,134 @@
+package ens
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const vitalik = "0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045"
+
+var resolverAddress = common.HexToAddress("0x231b0Ee14048e9dCcD1d247744d114a4EB5E8E63")
+
+func TestNamehash(t *testing.T) {
+	// EIP-137 vectors
+	tests := map[string]string{
+		"":            "0x0000000000000000000000000000000000000000000000000000000000000000",
+		"eth":         "0x93cdeb708b7545dc668eb9280176169d1c33cfd8ed6f04690a0bcc88a93fc4ae",
+		"foo.eth":     "0xde9b09fd7c5f901e23a3f19fecc54828e9c848539801e86591bd9801b019f84f",
+		"vitalik.eth": "0xee6c4522aab0003e8d14cd40a6af439055fd2577951148c14b6cea9a53475835",
+	}
+	for name, want := range tests {
+		if got := Namehash(name).Hex(); got != want {
+			t.Errorf("Namehash(%q) = %s, want %s", name, got, want)
+		}
+	}
+}
+
+// fakeENS is a ContractCaller answering like the ENS registry and a public resolver.
+type fakeENS struct {
+	// addrs are the addresses of the names with a resolver
+	addrs map[string]common.Address
+	// names are the reverse records
+	names map[common.Address]string
+}
+
+func (f fakeENS) CallContract(_ context.Context, msg ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	if len(msg.Data) != 4+wordLen {
+		return nil, errors.New("unexpected calldata length")
+	}
+	selector, node := string(msg.Data[:4]), common.BytesToHash(msg.Data[4:])
+
+	switch {
+	case *msg.To == RegistryAddress && selector == string(selectorResolver):
+		if f.hasRecord(node) {
+			return common.LeftPadBytes(resolverAddress[:], wordLen), nil
+		}
+		return make([]byte, wordLen), nil
+	case *msg.To == resolverAddress && selector == string(selectorAddr):
+		for name, addr := range f.addrs {
+			if Namehash(name) == node {
+				return common.LeftPadBytes(addr[:], wordLen), nil
+			}
+		}
+		return make([]byte, wordLen), nil
+	case *msg.To == resolverAddress && selector == string(selectorName):
+		for addr, name := range f.names {
+			if reverseNode(addr) == node {
+				return encodeString(name), nil
+			}
+		}
+		return encodeString(""), nil
+	}
+	return nil, errors.New("unexpected call")
+}
+
+func (f fakeENS) hasRecord(node common.Hash) bool {
+	for name := range f.addrs {
+		if Namehash(name) == node {
+			return true
+		}
+	}
+	for addr := range f.names {
+		if reverseNode(addr) == node {
+			return true
+		}
+	}
+	return false
+}
+
+func reverseNode(addr common.Address) common.Hash {
+	return Namehash(strings.ToLower(addr.Hex()[2:]) + reverseSuffix)
+}
+
+// encodeString ABI-encodes a string returned by a call.
+func encodeString(s string) []byte {
+	out := common.LeftPadBytes(big.NewInt(wordLen).Bytes(), wordLen)
+	out = append(out, common.LeftPadBytes(big.NewInt(int64(len(s))).Bytes(), wordLen)...)
+	return append(out, common.RightPadBytes([]byte(s), (len(s)+wordLen-1)/wordLen*wordLen)...)
+}
+
+func TestResolve(t *testing.T) {
+	client := fakeENS{addrs: map[string]common.Address{"vitalik.eth": common.HexToAddress(vitalik)}}
+
+	for _, name := range []string{"vitalik.eth", "Vitalik.ETH"} {
+		got, err := Resolve(context.Background(), name, client)
+		if err != nil || got != vitalik {
+			t.Errorf("Resolve(%q) = %q, %v, want %s", name, got, err, vitalik)
+		}
+	}
+
+	if got, err := Resolve(context.Background(), "nobody.eth", client); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Resolve(nobody.eth) = %q, %v, want ErrNotFound", got, err)
+	}
+	if got, err := Resolve(context.Background(), "a..eth", client); !errors.Is(err, ErrInvalidName) {
+		t.Errorf("Resolve(a..eth) = %q, %v, want ErrInvalidName", got, err)
+	}
+}
+
+func TestReverseResolve(t *testing.T) {
+	addr := common.HexToAddress(vitalik)
+	impostor := common.HexToAddress("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed")
+	client := fakeENS{
+		addrs: map[string]common.Address{"vitalik.eth": addr},
+		names: map[common.Address]string{addr: "vitalik.eth", impostor: "vitalik.eth"},
+	}
+
+	got, err := ReverseResolve(context.Background(), strings.ToLower(vitalik), client)
+	if err != nil || got != "vitalik.eth" {
+		t.Errorf("ReverseResolve() = %q, %v, want vitalik.eth", got, err)
+	}
+
+	// the reverse record of the impostor claims a name resolving to another address
+	if got, err := ReverseResolve(context.Background(), impostor.Hex(), client); !errors.Is(err, ErrNotFound) {
+		t.Errorf("ReverseResolve() of an impostor = %q, %v, want ErrNotFound", got, err)
+	}
+	if got, err := ReverseResolve(context.Background(), "0x1234", client); err == nil {
+		t.Errorf("ReverseResolve(0x1234) = %q, want an error", got)
+	}
+}
@@ -1,10 +1,16 @@
 package eth
 
 import (
+	"context"
+	"fmt"
 	"math/big"
 
+	"github.com/airsigner/libcrypto/chains/eth/oracle"
 	"github.com/airsigner/libcrypto/types"
+	"github.com/airsigner/libcrypto/types/pb"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/shopspring/decimal"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
 )
 
 type ethDefinition struct{}
@@ -12,40 +18,204 @@ type ethDefinition struct{}
 func (ethDefinition) CoinName() string { return "ETH" }
 func (ethDefinition) UnitExp() int32   { return 18 }
 
+// Denominations returns wei, gwei and ether, see types.CoinValue.Humanize.
+func (ethDefinition) Denominations() []types.Denomination {
+	return []types.Denomination{
+		{Name: "wei", Exp: 0},
+		{Name: "gwei", Exp: 9},
+		{Name: "ETH", Exp: 18},
+	}
+}
+
+// Denominations returns the denominations of Ether, from the smallest to the largest, see types.CoinValue.In.
+func Denominations() []types.Denomination {
+	return ethDefinition{}.Denominations()
+}
+
+// DefaultDust returns the default dust threshold for Ether payouts, 1000 GWei.
+//
+// Amounts below it cost more in fees to move than they are worth.
+func DefaultDust() *Eth {
+	return NewEthFromGWeil(decimal.NewFromInt(1000))
+}
+
+func init() {
+	types.Register(ethDefinition{}.CoinName(), func(wei *big.Int) types.Value {
+		return NewEthFromWei(wei)
+	})
+}
+
+var _ types.Value = (*Eth)(nil)
+
+// Eth is an amount of Ether.
+//
+// Its arithmetic methods, promoted from types.CoinValue, return a types.Value;
+// use NewEthFromValue or types.Rewrap to get an *Eth back, e.g. to call GWei on the result:
+//
+//	total, err := NewEthFromValue(a.Add(b))
+//	total, err := types.Rewrap(a.Add(b), NewEthFromWei)
 type Eth struct {
 	*types.CoinValue[ethDefinition]
 }
 
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, see types.CoinValue.UnmarshalBinary.
+func (e *Eth) UnmarshalBinary(data []byte) error {
+	return types.DecodeInto(&e.CoinValue, func(v *types.CoinValue[ethDefinition]) error {
+		return v.UnmarshalBinary(data)
+	})
+}
+
+// FromProto sets the value to the value of its protobuf representation, see types.CoinValue.FromProto.
+func (e *Eth) FromProto(m *pb.CoinValue) error {
+	return types.DecodeInto(&e.CoinValue, func(v *types.CoinValue[ethDefinition]) error {
+		return v.FromProto(m)
+	})
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, see types.CoinValue.UnmarshalCBOR.
+func (e *Eth) UnmarshalCBOR(data []byte) error {
+	return types.DecodeInto(&e.CoinValue, func(v *types.CoinValue[ethDefinition]) error {
+		return v.UnmarshalCBOR(data)
+	})
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler, see types.CoinValue.UnmarshalBSONValue.
+func (e *Eth) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	return types.DecodeInto(&e.CoinValue, func(v *types.CoinValue[ethDefinition]) error {
+		return v.UnmarshalBSONValue(t, data)
+	})
+}
+
 func NewEth(ether decimal.Decimal) *Eth {
 	return &Eth{
 		types.NewCoinValueFromCoins[ethDefinition](ether),
 	}
 }
 
+// NewEthExact is like NewEth but fails instead of truncating an amount more precise than the smallest unit.
+func NewEthExact(ether decimal.Decimal) (*Eth, error) {
+	cv, err := types.NewCoinValueFromCoinsChecked[ethDefinition](ether)
+	if err != nil {
+		return nil, err
+	}
+	return &Eth{cv}, nil
+}
+
+// NewEthFromString parses a decimal string amount of ether, e.g. "1.5".
+func NewEthFromString(s string) (*Eth, error) {
+	cv, err := types.ParseCoinValue[ethDefinition](s)
+	if err != nil {
+		return nil, err
+	}
+	return &Eth{cv}, nil
+}
+
+// MustNewEth is like NewEthFromString but panics if s can't be parsed, intended for tests and constants.
+func MustNewEth(s string) *Eth {
+	return &Eth{types.MustParseCoinValue[ethDefinition](s)}
+}
+
+// NewEthFromValue creates a Eth from a Value of the same coin, e.g. the result of Eth.Add.
+func NewEthFromValue(v types.Value) (*Eth, error) {
+	cv, err := types.NewCoinValueFromValue[ethDefinition](v)
+	if err != nil {
+		return nil, err
+	}
+	return &Eth{cv}, nil
+}
+
 func NewEthFromWei(wei *big.Int) *Eth {
 	return &Eth{
 		types.NewCoinValue[ethDefinition](wei),
 	}
 }
 
+// NewEthBalance is like NewEthFromWei but fails for a negative amount, which can't be a balance,
+// e.g. to guard a balance read from storage.
+func NewEthBalance(wei *big.Int) (*Eth, error) {
+	cv, err := types.NewNonNegativeCoinValue[ethDefinition](wei)
+	if err != nil {
+		return nil, err
+	}
+	return &Eth{cv}, nil
+}
+
 func NewEthFromKWei(kwei decimal.Decimal) *Eth {
 	return &Eth{
 		types.NewCoinValueFromScaled[ethDefinition](kwei, 3),
 	}
 }
 
+// NewEthFromKWeiExact is like NewEthFromKWei but fails instead of truncating an amount more precise than the wei.
+func NewEthFromKWeiExact(kwei decimal.Decimal) (*Eth, error) {
+	cv, err := types.NewCoinValueFromScaledChecked[ethDefinition](kwei, 3)
+	if err != nil {
+		return nil, err
+	}
+	return &Eth{cv}, nil
+}
+
 func NewEthFromMWei(mwei decimal.Decimal) *Eth {
 	return &Eth{
 		types.NewCoinValueFromScaled[ethDefinition](mwei, 6),
 	}
 }
 
+// NewEthFromMWeiExact is like NewEthFromMWei but fails instead of truncating an amount more precise than the wei.
+func NewEthFromMWeiExact(mwei decimal.Decimal) (*Eth, error) {
+	cv, err := types.NewCoinValueFromScaledChecked[ethDefinition](mwei, 6)
+	if err != nil {
+		return nil, err
+	}
+	return &Eth{cv}, nil
+}
+
 func NewEthFromGWeil(gwei decimal.Decimal) *Eth {
 	return &Eth{
 		types.NewCoinValueFromScaled[ethDefinition](gwei, 9),
 	}
 }
 
+// NewEthFromGWeiExact is like NewEthFromGWeil but fails instead of truncating an amount more precise than the wei.
+func NewEthFromGWeiExact(gwei decimal.Decimal) (*Eth, error) {
+	cv, err := types.NewCoinValueFromScaledChecked[ethDefinition](gwei, 9)
+	if err != nil {
+		return nil, err
+	}
+	return &Eth{cv}, nil
+}
+
+// NewEthFromRPCQuantity parses a JSON-RPC hex quantity denominated in wei.
+//
+// As required by EIP-1474 the quantity must be 0x prefixed and have no leading zeros, except for "0x0".
+func NewEthFromRPCQuantity(s string) (*Eth, error) {
+	wei, err := hexutil.DecodeBig(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid quantity %q: %w", s, err)
+	}
+	return NewEthFromWei(wei), nil
+}
+
+// NewEthFromHex parses a 0x prefixed hex amount of wei, e.g. "0x1bc16d674ec80000" for 2 Ether.
+//
+// Unlike NewEthFromRPCQuantity it accepts leading zeros, e.g. for amounts returned by eth_call,
+// see types.ParseHexUnits.
+func NewEthFromHex(hexStr string) (*Eth, error) {
+	wei, err := types.ParseHexUnits(hexStr)
+	if err != nil {
+		return nil, err
+	}
+	return NewEthFromWei(wei), nil
+}
+
+// NewEthFromHexBig creates an Eth from a go-ethereum hex encoded wei amount, nil being zero.
+func NewEthFromHexBig(h *hexutil.Big) *Eth {
+	if h == nil {
+		return NewEthFromWei(nil)
+	}
+	return NewEthFromWei(new(big.Int).Set(h.ToInt()))
+}
+
 // Wei returns the value of the Eth type in Wei.
 func (e Eth) Wei() *big.Int {
 	return e.Units()
@@ -70,3 +240,28 @@ func (e Eth) GWei() decimal.Decimal {
 func (e Eth) Eth() decimal.Decimal {
 	return e.Coins()
 }
+
+// ToRPCQuantity returns the value of the Eth type in wei as a JSON-RPC hex quantity.
+//
+// The quantity is encoded in its canonical form, with no leading zeros and "0x0" for zero.
+// Negative values have no valid quantity encoding and are prefixed with a minus sign.
+func (e Eth) ToRPCQuantity() string {
+	return hexutil.EncodeBig(e.Units())
+}
+
+// ToHexBig returns the value of the Eth type in wei as a go-ethereum hex encoded big integer.
+func (e Eth) ToHexBig() *hexutil.Big {
+	return (*hexutil.Big)(new(big.Int).Set(e.Units()))
+}
+
+// ValueUSD returns the value of the Eth type in USD, at the price given by the oracle.
+func (e Eth) ValueUSD(ctx context.Context, o oracle.PriceOracle) (decimal.Decimal, error) {
+	price, err := o.PriceUSD(ctx)
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("failed to get ETH price: %w", err)
+	}
+	return e.Eth().Mul(price), nil
+}
+
+// EthUnits is an amount of Ether encoded in JSON as a string of wei only, e.g. "1000", see types.UnitsOnly.
+type EthUnits = types.UnitsOnly[ethDefinition]
@@ -0,0 +1,350 @@
+package eth
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/airsigner/libcrypto/chains/eth/oracle"
+	"github.com/airsigner/libcrypto/types"
+	"github.com/airsigner/libcrypto/types/pb"
+	"github.com/fxamacker/cbor/v2"
+	"github.com/shopspring/decimal"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestToRPCQuantity(t *testing.T) {
+	tests := []struct {
+		wei  int64
+		want string
+	}{
+		{0, "0x0"},
+		{1, "0x1"},
+		{65, "0x41"},
+		{1024, "0x400"},
+		{1e18, "0xde0b6b3a7640000"},
+	}
+	for _, tt := range tests {
+		e := NewEthFromWei(big.NewInt(tt.wei))
+		if got := e.ToRPCQuantity(); got != tt.want {
+			t.Errorf("ToRPCQuantity() of %d wei = %q, want %q", tt.wei, got, tt.want)
+		}
+		text, err := e.ToHexBig().MarshalText()
+		if err != nil {
+			t.Fatalf("ToHexBig().MarshalText() of %d wei: %v", tt.wei, err)
+		}
+		if string(text) != tt.want {
+			t.Errorf("ToHexBig() of %d wei = %q, want %q", tt.wei, text, tt.want)
+		}
+	}
+}
+
+func TestNewEthFromRPCQuantity(t *testing.T) {
+	// EIP-1474 quantity examples
+	valid := map[string]int64{
+		"0x0":   0,
+		"0x41":  65,
+		"0x400": 1024,
+	}
+	for s, want := range valid {
+		e, err := NewEthFromRPCQuantity(s)
+		if err != nil {
+			t.Errorf("NewEthFromRPCQuantity(%q): %v", s, err)
+			continue
+		}
+		if e.Wei().Cmp(big.NewInt(want)) != 0 {
+			t.Errorf("NewEthFromRPCQuantity(%q) = %s wei, want %d", s, e.Wei(), want)
+		}
+		if got := e.ToRPCQuantity(); got != s {
+			t.Errorf("NewEthFromRPCQuantity(%q).ToRPCQuantity() = %q", s, got)
+		}
+	}
+
+	invalid := []string{
+		"0x",     // no digits
+		"0x0400", // leading zero
+		"0x00",   // leading zero
+		"ff",     // no prefix
+		"0xfg",   // not hex
+		"-0x1",   // negative
+		"",
+	}
+	for _, s := range invalid {
+		if e, err := NewEthFromRPCQuantity(s); err == nil {
+			t.Errorf("NewEthFromRPCQuantity(%q) = %s, want an error", s, e)
+		}
+	}
+}
+
+// nilValues are the two forms of a missing Value: a nil interface, and a nil pointer wrapped in the interface.
+var nilValues = []struct {
+	name  string
+	value types.Value
+}{
+	{"nil", nil},
+	{"nil *Eth", (*Eth)(nil)},
+}
+
+func TestNewEthFromHex(t *testing.T) {
+	valid := map[string]string{
+		"0x1bc16d674ec80000":     "2",
+		"0x1BC16D674EC80000":     "2",
+		"0x00001bc16d674ec80000": "2",
+		"0x0":                    "0",
+		"0x1":                    "0.000000000000000001",
+	}
+	for s, want := range valid {
+		e, err := NewEthFromHex(s)
+		if err != nil || e.Eth().String() != want {
+			t.Errorf("NewEthFromHex(%q) = %v, %v, want %s ETH", s, e, err, want)
+		}
+	}
+
+	for _, s := range []string{"", "0x", "1bc16d674ec80000", "0xfg", "-0x1"} {
+		if e, err := NewEthFromHex(s); err == nil {
+			t.Errorf("NewEthFromHex(%q) = %s, want an error", s, e)
+		}
+	}
+}
+
+func TestNewEthBalance(t *testing.T) {
+	for _, wei := range []*big.Int{big.NewInt(0), big.NewInt(1), big.NewInt(1e18)} {
+		got, err := NewEthBalance(wei)
+		if err != nil {
+			t.Fatalf("NewEthBalance(%s) error = %v", wei, err)
+		}
+		if got.Units().Cmp(wei) != 0 {
+			t.Errorf("NewEthBalance(%s) = %s wei", wei, got.Units())
+		}
+	}
+
+	if got, err := NewEthBalance(big.NewInt(-1)); !errors.Is(err, types.ErrOutOfRange) || got != nil {
+		t.Errorf("NewEthBalance(-1) = %v, %v, want nil, types.ErrOutOfRange", got, err)
+	}
+}
+
+func TestNilValueErrors(t *testing.T) {
+	one := MustNewEth("1")
+	tests := []struct {
+		name string
+		call func(v types.Value) error
+	}{
+		{"NewEthFromValue", func(v types.Value) error { _, err := NewEthFromValue(v); return err }},
+		{"IsDust", func(v types.Value) error { _, err := one.IsDust(v); return err }},
+		{"Clamp min", func(v types.Value) error { _, err := one.Clamp(v, one); return err }},
+		{"Clamp max", func(v types.Value) error { _, err := one.Clamp(one, v); return err }},
+		{"FractionOf", func(v types.Value) error { _, err := one.FractionOf(v); return err }},
+		{"Settle", func(v types.Value) error { _, err := types.Settle(v, one, one); return err }},
+		{"Balance.Apply", func(v types.Value) error { return types.NewBalance[ethDefinition](false).Apply(v) }},
+		{"PackTransfer", func(v types.Value) error { _, err := PackTransfer(Address{}, v); return err }},
+	}
+	for _, tt := range tests {
+		for _, nv := range nilValues {
+			t.Run(tt.name+"/"+nv.name, func(t *testing.T) {
+				if err := tt.call(nv.value); !errors.Is(err, types.ErrNilValue) {
+					t.Errorf("error = %v, want types.ErrNilValue", err)
+				}
+			})
+		}
+	}
+}
+
+func TestNilValuePanics(t *testing.T) {
+	one := MustNewEth("1")
+	tests := []struct {
+		name string
+		call func(v types.Value)
+	}{
+		{"Add", func(v types.Value) { one.Add(v) }},
+		{"Sub", func(v types.Value) { one.Sub(v) }},
+		{"Mul", func(v types.Value) { one.Mul(v) }},
+		{"Div", func(v types.Value) { one.Div(v) }},
+		{"Cmp", func(v types.Value) { one.Cmp(v) }},
+	}
+	for _, tt := range tests {
+		for _, nv := range nilValues {
+			t.Run(tt.name+"/"+nv.name, func(t *testing.T) {
+				defer func() {
+					err, _ := recover().(error)
+					if !errors.Is(err, types.ErrNilValue) {
+						t.Errorf("panic = %v, want an error wrapping types.ErrNilValue", err)
+					}
+				}()
+				tt.call(nv.value)
+			})
+		}
+	}
+}
+
+func TestNilValueComparisons(t *testing.T) {
+	one := MustNewEth("1")
+	for _, nv := range nilValues {
+		t.Run(nv.name, func(t *testing.T) {
+			if one.Same(nv.value) {
+				t.Errorf("Same() = true, want false")
+			}
+			if types.ValuesEqual(one, nv.value) || types.ValuesEqual(nv.value, one) {
+				t.Errorf("ValuesEqual() = true with a single nil Value, want false")
+			}
+			for _, other := range nilValues {
+				if !types.ValuesEqual(nv.value, other.value) {
+					t.Errorf("ValuesEqual(%s, %s) = false, want true", nv.name, other.name)
+				}
+			}
+		})
+	}
+}
+
+func TestNewEthFromDenominations(t *testing.T) {
+	tests := []struct {
+		name string
+		eth  *Eth
+		wei  int64
+	}{
+		{"kwei", NewEthFromKWei(decimal.NewFromInt(1)), 1_000},
+		{"mwei", NewEthFromMWei(decimal.NewFromInt(1)), 1_000_000},
+		{"gwei", NewEthFromGWeil(decimal.NewFromInt(1)), 1_000_000_000},
+		{"ether", NewEth(decimal.NewFromInt(1)), 1_000_000_000_000_000_000},
+	}
+	for _, tt := range tests {
+		if tt.eth.Wei().Int64() != tt.wei {
+			t.Errorf("1 %s = %s wei, want %d", tt.name, tt.eth.Wei(), tt.wei)
+		}
+	}
+
+	e := NewEthFromWei(big.NewInt(1_500_000))
+	if e.KWei().String() != "1500" || e.MWei().String() != "1.5" {
+		t.Errorf("KWei() = %s, MWei() = %s, want 1500 and 1.5", e.KWei(), e.MWei())
+	}
+}
+
+func TestEthBinaryRoundTrip(t *testing.T) {
+	want := MustNewEth("1.5")
+	data, err := want.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got Eth
+	if err := got.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary() into a zero Eth: %v", err)
+	}
+	if !want.Equals(got) {
+		t.Errorf("UnmarshalBinary() = %s, want %s", got, want)
+	}
+
+	// 1 unit of BTC
+	if err := got.UnmarshalBinary([]byte{0, 1, 1, 'B', 'T', 'C'}); !errors.Is(err, types.ErrCoinMismatch) {
+		t.Errorf("UnmarshalBinary() of another coin = %v, want ErrCoinMismatch", err)
+	}
+}
+
+// fixedOracle is a PriceOracle returning a fixed price, or an error.
+type fixedOracle struct {
+	price decimal.Decimal
+	err   error
+}
+
+func (o fixedOracle) PriceUSD(context.Context) (decimal.Decimal, error) {
+	return o.price, o.err
+}
+
+func TestValueUSD(t *testing.T) {
+	got, err := MustNewEth("1.5").ValueUSD(context.Background(), fixedOracle{price: decimal.RequireFromString("3456.78")})
+	if err != nil || got.String() != "5185.17" {
+		t.Errorf("ValueUSD() = %s, %v, want 5185.17", got, err)
+	}
+
+	_, err = MustNewEth("1.5").ValueUSD(context.Background(), fixedOracle{err: oracle.ErrStalePrice})
+	if !errors.Is(err, oracle.ErrStalePrice) {
+		t.Errorf("ValueUSD() error = %v, want ErrStalePrice", err)
+	}
+}
+
+func TestRegistered(t *testing.T) {
+	v, err := types.NewByName("ETH", big.NewInt(1_500_000_000_000_000_000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	e, ok := v.(*Eth)
+	if !ok {
+		t.Fatalf("NewByName(ETH) = %T, want *Eth", v)
+	}
+	if e.Eth().String() != "1.5" || e.GWei().String() != "1500000000" {
+		t.Errorf("NewByName(ETH) = %s ETH, want 1.5", e.Eth())
+	}
+}
+
+func TestEthProtoRoundTrip(t *testing.T) {
+	want := MustNewEth("1.5")
+
+	var got Eth
+	if err := got.FromProto(want.ToProto()); err != nil {
+		t.Fatalf("FromProto() into a zero Eth: %v", err)
+	}
+	if !want.Equals(got) {
+		t.Errorf("FromProto() = %s, want %s", got, want)
+	}
+
+	if err := got.FromProto(&pb.CoinValue{Coin: "BTC", Units: []byte{1}}); !errors.Is(err, types.ErrCoinMismatch) {
+		t.Errorf("FromProto() of another coin = %v, want ErrCoinMismatch", err)
+	}
+	if !want.Equals(got) {
+		t.Errorf("a failed FromProto() modified the value: %s", got)
+	}
+}
+
+func TestEthCBORRoundTrip(t *testing.T) {
+	want := MustNewEth("1.5")
+	data, err := cbor.Marshal(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got Eth
+	if err := cbor.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal() into a zero Eth: %v", err)
+	}
+	if !want.Equals(got) {
+		t.Errorf("Unmarshal() = %s, want %s", got, want)
+	}
+
+	// {1: "BTC", 2: 1}
+	if err := cbor.Unmarshal([]byte{0xa2, 0x01, 0x63, 'B', 'T', 'C', 0x02, 0x01}, &got); !errors.Is(err, types.ErrCoinMismatch) {
+		t.Errorf("Unmarshal() of another coin = %v, want ErrCoinMismatch", err)
+	}
+}
+
+func TestEthBSONRoundTrip(t *testing.T) {
+	type document struct {
+		Amount Eth  `bson:"amount"`
+		Fee    *Eth `bson:"fee"`
+	}
+
+	want := document{Amount: *MustNewEth("1.5"), Fee: NewEthFromWei(big.NewInt(21_000))}
+	data, err := bson.Marshal(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got document
+	if err := bson.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal() into zero Eth fields: %v", err)
+	}
+	if !want.Amount.Equals(got.Amount) || !want.Fee.Equals(got.Fee) {
+		t.Errorf("Unmarshal() = %s, %s, want %s, %s", got.Amount, got.Fee, want.Amount, want.Fee)
+	}
+
+	btc, err := bson.Marshal(bson.D{{Key: "amount", Value: bson.D{{Key: "coin", Value: "BTC"}, {Key: "units", Value: 1}}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var mismatched document
+	if err := bson.Unmarshal(btc, &mismatched); !errors.Is(err, types.ErrCoinMismatch) {
+		t.Errorf("Unmarshal() of another coin = %v, want ErrCoinMismatch", err)
+	}
+	if mismatched.Amount.CoinValue != nil {
+		t.Errorf("a failed Unmarshal() set the value to %s", mismatched.Amount)
+	}
+}
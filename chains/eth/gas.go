@@ -0,0 +1,190 @@
+package eth
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/shopspring/decimal"
+)
+
+// GasCost returns the cost of the given amount of gas, the Eth type being the gas price.
+func (e Eth) GasCost(gasUsed uint64) *Eth {
+	return NewEthFromWei(new(big.Int).Mul(e.Wei(), new(big.Int).SetUint64(gasUsed)))
+}
+
+// PerGas returns the price per gas of the given amount of gas, the Eth type being the total fee.
+//
+// It is the inverse of GasCost: the result is truncated to the wei,
+// so it round-trips exactly only when the fee is a multiple of the gas used.
+func (e Eth) PerGas(gasUsed uint64) (*Eth, error) {
+	if gasUsed == 0 {
+		return nil, errors.New("gas used must not be zero")
+	}
+	return NewEthFromWei(new(big.Int).Quo(e.Wei(), new(big.Int).SetUint64(gasUsed))), nil
+}
+
+// CmpGwei compares two gas prices, the Eth type being one of them.
+//
+// It is the primitive to compare gas prices with: the comparison is done on wei,
+// so prices differing by a single wei aren't considered equal as they could be
+// when compared through their rounded GWei amounts.
+//
+// Returns:
+// - int: -1 if e < other, 0 if e == other and +1 if e > other.
+func (e Eth) CmpGwei(other *Eth) int {
+	return e.Wei().Cmp(other.Wei())
+}
+
+// IsCheaperThan checks if the gas price is strictly lower than the other gas price, see CmpGwei.
+func (e Eth) IsCheaperThan(other *Eth) bool {
+	return e.CmpGwei(other) < 0
+}
+
+// SplitFee splits the fee paid by a confirmed transaction between the base fee, which is burned,
+// and the tip paid to the validator.
+//
+// Parameters:
+// - gasUsed: the gas used by the transaction.
+// - baseFee: the base fee per gas of the block.
+// - effectiveGasPrice: the gas price paid by the transaction.
+//
+// Returns:
+// - burned: the part of the fee that was burned.
+// - tip: the part of the fee paid to the validator.
+// - err: an error if the effective gas price is below the base fee.
+func SplitFee(gasUsed uint64, baseFee, effectiveGasPrice *Eth) (burned *Eth, tip *Eth, err error) {
+	if effectiveGasPrice.Cmp(baseFee) < 0 {
+		return nil, nil, fmt.Errorf("effective gas price %s GWei is below base fee %s GWei", effectiveGasPrice.GWei(), baseFee.GWei())
+	}
+
+	tipPerGas := NewEthFromWei(effectiveGasPrice.Sub(baseFee).Units())
+	return baseFee.GasCost(gasUsed), tipPerGas.GasCost(gasUsed), nil
+}
+
+// TotalGasCost returns the total cost of the gas used by a batch of transactions, e.g. for cost reports.
+//
+// Only the execution fees are counted: the L1 data fees of L2 rollups aren't part of the gas used.
+//
+// Parameters:
+// - receipts: the receipts of the transactions.
+// - prices: the gas price paid by each transaction, in the order of receipts; a nil price uses
+// the EffectiveGasPrice of the receipt.
+//
+// Returns:
+// - *Eth: the sum of the gas used by each transaction at its gas price.
+// - error: an error if receipts and prices have different lengths, a receipt is nil
+// or a transaction has no gas price.
+func TotalGasCost(receipts []*gethtypes.Receipt, prices []*Eth) (*Eth, error) {
+	if len(receipts) != len(prices) {
+		return nil, fmt.Errorf("%d receipts but %d prices", len(receipts), len(prices))
+	}
+
+	total, cost := new(big.Int), new(big.Int)
+	for i, receipt := range receipts {
+		if receipt == nil {
+			return nil, fmt.Errorf("receipt %d is nil", i)
+		}
+
+		var price *big.Int
+		switch {
+		case prices[i] != nil:
+			price = prices[i].Wei()
+		case receipt.EffectiveGasPrice != nil:
+			price = receipt.EffectiveGasPrice
+		default:
+			return nil, fmt.Errorf("no gas price for receipt %d of transaction %s", i, receipt.TxHash)
+		}
+
+		cost.SetUint64(receipt.GasUsed)
+		total.Add(total, cost.Mul(cost, price))
+	}
+	return NewEthFromWei(total), nil
+}
+
+// FeeParams are the EIP-1559 fee parameters of a transaction.
+type FeeParams struct {
+	GasLimit             uint64
+	MaxFeePerGas         *Eth
+	MaxPriorityFeePerGas *Eth
+}
+
+// MaxCost returns the maximum fee the transaction can be charged, i.e. the gas limit at the max fee per gas.
+func (f FeeParams) MaxCost() *Eth {
+	return f.MaxFeePerGas.GasCost(f.GasLimit)
+}
+
+// TotalSpend returns the maximum amount a transaction sending value can cost, fees included.
+func TotalSpend(value *Eth, fee FeeParams) *Eth {
+	return NewEthFromWei(value.Add(fee.MaxCost()).Units())
+}
+
+// CanAfford checks if the balance covers sending value, fees included.
+func CanAfford(balance, value *Eth, fee FeeParams) bool {
+	return balance.Cmp(TotalSpend(value, fee)) >= 0
+}
+
+// GasCostFromGweiString returns the cost of the gas used at a gas price given as a decimal string in GWei,
+// e.g. "30" or "1.5", as found in configuration files.
+//
+// Parameters:
+// - gweiStr: the gas price in GWei.
+// - gasUsed: the amount of gas.
+//
+// Returns:
+// - *Eth: the cost of the gas.
+// - error: an error if the gas price isn't a decimal number, is negative or is more precise than the wei.
+func GasCostFromGweiString(gweiStr string, gasUsed uint64) (*Eth, error) {
+	gwei, err := decimal.NewFromString(gweiStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid gas price %q: %w", gweiStr, err)
+	}
+	if gwei.IsNegative() {
+		return nil, fmt.Errorf("negative gas price %s GWei", gwei)
+	}
+
+	wei := gwei.Shift(9)
+	if !wei.IsInteger() {
+		return nil, fmt.Errorf("gas price %s GWei is more precise than the wei", gwei)
+	}
+	return NewEthFromWei(wei.BigInt()).GasCost(gasUsed), nil
+}
+
+// BumpFee returns the fee parameters of a transaction replacing a stuck one,
+// both the max fee and the max priority fee per gas being increased by percent.
+//
+// Nodes only accept a replacement whose fees are higher by a minimum percentage, usually 10%,
+// so the increased fees are rounded up to the wei to never under-bump.
+// BumpFee panics if percent is negative.
+//
+// Parameters:
+// - old: the fee parameters of the stuck transaction.
+// - percent: the percentage to increase the fees by, e.g. 10 for 10%.
+//
+// Returns:
+// - FeeParams: the fee parameters of the replacement, with the same gas limit.
+func BumpFee(old FeeParams, percent decimal.Decimal) FeeParams {
+	return FeeParams{
+		GasLimit:             old.GasLimit,
+		MaxFeePerGas:         BumpLegacyGasPrice(old.MaxFeePerGas, percent),
+		MaxPriorityFeePerGas: BumpLegacyGasPrice(old.MaxPriorityFeePerGas, percent),
+	}
+}
+
+// BumpLegacyGasPrice returns the gas price of a legacy transaction replacing a stuck one, see BumpFee.
+//
+// Parameters:
+// - old: the gas price of the stuck transaction.
+// - percent: the percentage to increase the gas price by, e.g. 10 for 10%.
+//
+// Returns:
+// - *Eth: the gas price increased by percent, rounded up to the wei.
+func BumpLegacyGasPrice(old *Eth, percent decimal.Decimal) *Eth {
+	if percent.IsNegative() {
+		panic("cannot bump a fee by a negative percentage")
+	}
+
+	factor := decimal.New(1, 0).Add(percent.Shift(-2))
+	return NewEthFromWei(decimal.NewFromBigInt(old.Wei(), 0).Mul(factor).Ceil().BigInt())
+}
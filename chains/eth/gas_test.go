@@ -0,0 +1,110 @@
+package eth
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestPerGas(t *testing.T) {
+	tests := []struct {
+		fee     int64
+		gasUsed uint64
+		want    int64
+	}{
+		{21000 * 30_000_000_000, 21000, 30_000_000_000},
+		// truncated to the wei
+		{21000*30_000_000_000 + 20999, 21000, 30_000_000_000},
+		{0, 21000, 0},
+	}
+	for _, tt := range tests {
+		got, err := NewEthFromWei(big.NewInt(tt.fee)).PerGas(tt.gasUsed)
+		if err != nil || got.Wei().Int64() != tt.want {
+			t.Errorf("PerGas(%d) of %d wei = %v, %v, want %d wei", tt.gasUsed, tt.fee, got, err, tt.want)
+		}
+	}
+
+	price := NewEthFromWei(big.NewInt(30_000_000_000))
+	if got, err := price.GasCost(21000).PerGas(21000); err != nil || !got.Equals(price) {
+		t.Errorf("PerGas() of GasCost() = %v, %v, want %s", got, err, price)
+	}
+	if _, err := price.PerGas(0); err == nil {
+		t.Errorf("PerGas(0) succeeded, want an error")
+	}
+}
+
+func TestGasCostFromGweiString(t *testing.T) {
+	tests := []struct {
+		gwei string
+		want int64
+	}{
+		{"30", 21000 * 30_000_000_000},
+		{"1.5", 21000 * 1_500_000_000},
+		{"0.000000001", 21000},
+		{"0", 0},
+	}
+	for _, tt := range tests {
+		got, err := GasCostFromGweiString(tt.gwei, 21000)
+		if err != nil || got.Wei().Int64() != tt.want {
+			t.Errorf("GasCostFromGweiString(%q, 21000) = %v, %v, want %d wei", tt.gwei, got, err, tt.want)
+		}
+	}
+
+	for _, gwei := range []string{"", "abc", "1.5.0", "0x1e", "-1", "0.0000000001"} {
+		if got, err := GasCostFromGweiString(gwei, 21000); err == nil {
+			t.Errorf("GasCostFromGweiString(%q, 21000) = %s, want an error", gwei, got)
+		}
+	}
+}
+
+func TestCmpGwei(t *testing.T) {
+	price := NewEthFromWei(big.NewInt(30_000_000_000))
+	oneWeiMore := NewEthFromWei(big.NewInt(30_000_000_001))
+
+	// both round to 30 GWei
+	if !price.GWei().Round(0).Equal(oneWeiMore.GWei().Round(0)) {
+		t.Fatalf("GWei() of the prices differ when rounded")
+	}
+	if got := price.CmpGwei(oneWeiMore); got != -1 {
+		t.Errorf("CmpGwei() = %d, want -1", got)
+	}
+	if got := oneWeiMore.CmpGwei(price); got != 1 {
+		t.Errorf("CmpGwei() = %d, want 1", got)
+	}
+	if got := price.CmpGwei(NewEthFromGWeil(decimal.NewFromInt(30))); got != 0 {
+		t.Errorf("CmpGwei() = %d, want 0", got)
+	}
+}
+
+func TestBumpFee(t *testing.T) {
+	old := FeeParams{
+		GasLimit:             21000,
+		MaxFeePerGas:         NewEthFromWei(big.NewInt(30_000_000_001)),
+		MaxPriorityFeePerGas: NewEthFromWei(big.NewInt(1_000_000_000)),
+	}
+	tests := []struct {
+		percent     string
+		maxFee      int64
+		maxPriority int64
+	}{
+		// 33000000001.1 rounded up
+		{"10", 33_000_000_002, 1_100_000_000},
+		// 33750000001.125 rounded up
+		{"12.5", 33_750_000_002, 1_125_000_000},
+		{"0", 30_000_000_001, 1_000_000_000},
+	}
+	for _, tt := range tests {
+		got := BumpFee(old, decimal.RequireFromString(tt.percent))
+		if got.GasLimit != old.GasLimit {
+			t.Errorf("BumpFee(%s%%) gas limit = %d, want %d", tt.percent, got.GasLimit, old.GasLimit)
+		}
+		if got.MaxFeePerGas.Wei().Int64() != tt.maxFee || got.MaxPriorityFeePerGas.Wei().Int64() != tt.maxPriority {
+			t.Errorf("BumpFee(%s%%) = %s/%s wei, want %d/%d", tt.percent, got.MaxFeePerGas.Wei(), got.MaxPriorityFeePerGas.Wei(), tt.maxFee, tt.maxPriority)
+		}
+	}
+
+	if old.MaxFeePerGas.Wei().Int64() != 30_000_000_001 {
+		t.Errorf("BumpFee() modified the old fees")
+	}
+}
@@ -0,0 +1,67 @@
+// Package logs decodes the event logs of EVM contracts.
+package logs
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+var (
+	// ErrNotTransfer is returned when a log isn't an ERC-20 Transfer event.
+	ErrNotTransfer = errors.New("not an erc20 transfer log")
+
+	// TransferTopic is the topic of the Transfer(address,address,uint256) event,
+	// i.e. the Keccak-256 hash of its signature.
+	TransferTopic = common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+)
+
+const wordLen = 32
+
+// DecodeTransfer decodes an ERC-20 Transfer(address indexed from, address indexed to, uint256 value) event log.
+//
+// ERC-721 Transfer events share the signature of the ERC-20 one but index the token ID as a third topic,
+// they are rejected with ErrNotTransfer.
+//
+// Parameters:
+// - log: the log to decode, e.g. from a receipt or eth_getLogs.
+//
+// Returns:
+// - from: the checksummed address of the sender, the zero address for a mint.
+// - to: the checksummed address of the recipient, the zero address for a burn.
+// - amount: the amount transferred, in the units of the token.
+// - err: an error wrapping ErrNotTransfer if the log isn't an ERC-20 Transfer event, or an error if it is malformed.
+func DecodeTransfer(log gethtypes.Log) (from, to string, amount *big.Int, err error) {
+	if len(log.Topics) == 0 || log.Topics[0] != TransferTopic {
+		return "", "", nil, ErrNotTransfer
+	}
+	if len(log.Topics) != 3 {
+		return "", "", nil, fmt.Errorf("%w: got %d topics, want 3", ErrNotTransfer, len(log.Topics))
+	}
+	if len(log.Data) != wordLen {
+		return "", "", nil, fmt.Errorf("invalid transfer data length %d", len(log.Data))
+	}
+
+	fromAddr, err := topicAddress(log.Topics[1])
+	if err != nil {
+		return "", "", nil, fmt.Errorf("invalid transfer sender: %w", err)
+	}
+	toAddr, err := topicAddress(log.Topics[2])
+	if err != nil {
+		return "", "", nil, fmt.Errorf("invalid transfer recipient: %w", err)
+	}
+	return fromAddr.Hex(), toAddr.Hex(), new(big.Int).SetBytes(log.Data), nil
+}
+
+// topicAddress decodes an indexed address, left padded with zeros to 32 bytes.
+func topicAddress(topic common.Hash) (common.Address, error) {
+	for _, b := range topic[:wordLen-common.AddressLength] {
+		if b != 0 {
+			return common.Address{}, fmt.Errorf("dirty address padding in topic %s", topic)
+		}
+	}
+	return common.BytesToAddress(topic[wordLen-common.AddressLength:]), nil
+}
@@ -0,0 +1,97 @@
+package logs
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	sender    = "0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045"
+	recipient = "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"
+)
+
+func transferLog(amount *big.Int) gethtypes.Log {
+	return gethtypes.Log{
+		Topics: []common.Hash{
+			TransferTopic,
+			common.BytesToHash(common.HexToAddress(sender).Bytes()),
+			common.BytesToHash(common.HexToAddress(recipient).Bytes()),
+		},
+		Data: common.LeftPadBytes(amount.Bytes(), wordLen),
+	}
+}
+
+func TestTransferTopic(t *testing.T) {
+	if want := crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)")); TransferTopic != want {
+		t.Errorf("TransferTopic = %s, want %s", TransferTopic, want)
+	}
+}
+
+func TestDecodeTransfer(t *testing.T) {
+	maxUint256 := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+	for _, amount := range []*big.Int{big.NewInt(0), big.NewInt(1_000_000), maxUint256} {
+		from, to, got, err := DecodeTransfer(transferLog(amount))
+		if err != nil {
+			t.Fatalf("DecodeTransfer(%s) error = %v", amount, err)
+		}
+		if from != sender || to != recipient {
+			t.Errorf("DecodeTransfer(%s) = %s -> %s, want %s -> %s", amount, from, to, sender, recipient)
+		}
+		if got.Cmp(amount) != 0 {
+			t.Errorf("DecodeTransfer() amount = %s, want %s", got, amount)
+		}
+	}
+}
+
+func TestDecodeTransferMint(t *testing.T) {
+	log := transferLog(big.NewInt(1))
+	log.Topics[1] = common.Hash{}
+	from, _, _, err := DecodeTransfer(log)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (common.Address{}).Hex(); from != want {
+		t.Errorf("DecodeTransfer() from = %s, want the zero address %s", from, want)
+	}
+}
+
+func TestDecodeTransferInvalid(t *testing.T) {
+	tests := []struct {
+		name        string
+		modify      func(log *gethtypes.Log)
+		notTransfer bool
+	}{
+		{"no topics", func(log *gethtypes.Log) { log.Topics = nil }, true},
+		{"other event", func(log *gethtypes.Log) {
+			log.Topics[0] = crypto.Keccak256Hash([]byte("Approval(address,address,uint256)"))
+		}, true},
+		{"erc721 token id topic", func(log *gethtypes.Log) {
+			log.Topics = append(log.Topics, common.BigToHash(big.NewInt(1)))
+			log.Data = nil
+		}, true},
+		{"missing recipient topic", func(log *gethtypes.Log) { log.Topics = log.Topics[:2] }, true},
+		{"empty data", func(log *gethtypes.Log) { log.Data = nil }, false},
+		{"short data", func(log *gethtypes.Log) { log.Data = log.Data[1:] }, false},
+		{"long data", func(log *gethtypes.Log) { log.Data = append(log.Data, 0) }, false},
+		{"dirty sender padding", func(log *gethtypes.Log) { log.Topics[1][0] = 1 }, false},
+		{"dirty recipient padding", func(log *gethtypes.Log) { log.Topics[2][11] = 1 }, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			log := transferLog(big.NewInt(1))
+			tt.modify(&log)
+			_, _, _, err := DecodeTransfer(log)
+			if err == nil {
+				t.Fatal("DecodeTransfer() error = nil, want an error")
+			}
+			if got := errors.Is(err, ErrNotTransfer); got != tt.notTransfer {
+				t.Errorf("DecodeTransfer() error = %v, is ErrNotTransfer = %v, want %v", err, got, tt.notTransfer)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package eth
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const signatureLen = 65
+
+// HashPersonalMessage returns the EIP-191 hash of a message signed with personal_sign,
+// i.e. keccak256("\x19Ethereum Signed Message:\n" + len(data) + data).
+func HashPersonalMessage(data []byte) common.Hash {
+	return common.BytesToHash(accounts.TextHash(data))
+}
+
+// RecoverSigner returns the address that signed a message with personal_sign, see HashPersonalMessage.
+//
+// Parameters:
+// - data: the message that was signed.
+// - sig: the 65 bytes [R || S || V] signature, V being the recovery ID either as 0/1 or as 27/28.
+//
+// Returns:
+// - Address: the address of the signer.
+// - error: an error if the signature is invalid, see RecoverHashSigner.
+func RecoverSigner(data []byte, sig []byte) (Address, error) {
+	return RecoverHashSigner(HashPersonalMessage(data), sig)
+}
+
+// RecoverHashSigner returns the address that signed a hash.
+//
+// Parameters:
+// - hash: the hash that was signed.
+// - sig: the 65 bytes [R || S || V] signature, V being the recovery ID either as 0/1 or as 27/28.
+//
+// Returns:
+// - Address: the address of the signer.
+// - error: an error if the signature is malformed, malleable (S in the upper half of the curve order)
+// or doesn't allow recovering a public key.
+func RecoverHashSigner(hash common.Hash, sig []byte) (Address, error) {
+	if len(sig) != signatureLen {
+		return Address{}, fmt.Errorf("invalid signature length %d", len(sig))
+	}
+
+	sig = append([]byte(nil), sig...)
+	switch v := sig[signatureLen-1]; v {
+	case 0, 1:
+	case 27, 28:
+		sig[signatureLen-1] = v - 27
+	default:
+		return Address{}, fmt.Errorf("invalid signature recovery ID %d", v)
+	}
+
+	r, s := new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:64])
+	if !crypto.ValidateSignatureValues(sig[signatureLen-1], r, s, true) {
+		return Address{}, errors.New("invalid signature values")
+	}
+
+	pub, err := crypto.SigToPub(hash.Bytes(), sig)
+	if err != nil {
+		return Address{}, fmt.Errorf("failed to recover signer: %w", err)
+	}
+	return AddressFromPubKey(pub), nil
+}
@@ -0,0 +1,96 @@
+package eth
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// the signer of the vectors, the key of the web3.js documentation 0x4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318
+const signer = "0x2c7536E3605D9C16a7a3D7b1898e529396a65c23"
+
+func TestHashPersonalMessage(t *testing.T) {
+	tests := []struct {
+		message string
+		want    string
+	}{
+		{"Some data", "0x1da44b586eb0729ff70a73c326926f6ed5a25f5b056e7f47fbc6e58d86871655"},
+		{"hello world", "0xd9eba16ed0ecae432b71fe008c98cc872bb4cc214d3220a36f365326cf807d68"},
+		{"", "0x5f35dce98ba4fba25530a026ed80b2cecdaa31091ba4958b99b52ea1d068adad"},
+	}
+	for _, tt := range tests {
+		if got := HashPersonalMessage([]byte(tt.message)); got.Hex() != tt.want {
+			t.Errorf("HashPersonalMessage(%q) = %s, want %s", tt.message, got.Hex(), tt.want)
+		}
+	}
+}
+
+func TestRecoverSigner(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		sig     string
+	}{
+		// the example of web3.eth.accounts.sign
+		{"recovery ID 28", "Some data", "0xb91467e570a6466aa9e9876cbcd013baba02900b8979d43fe208a4a4f339f5fd6007e74cd82e037b800186422fc2da167c747ef045e5d18a5f5d4300f8e1a0291c"},
+		{"recovery ID 1", "Some data", "0xb91467e570a6466aa9e9876cbcd013baba02900b8979d43fe208a4a4f339f5fd6007e74cd82e037b800186422fc2da167c747ef045e5d18a5f5d4300f8e1a02901"},
+		{"recovery ID 27", "", "0x8a68b4e66cd2b575338e16069d7b65f6f67c7ceae8945dccf8cb7bdb06278d933dd9c888f3444ca4698464079a067ad3cfffe96d493b8ecf56885169d0fdfe7d1b"},
+		{"recovery ID 0", "", "0x8a68b4e66cd2b575338e16069d7b65f6f67c7ceae8945dccf8cb7bdb06278d933dd9c888f3444ca4698464079a067ad3cfffe96d493b8ecf56885169d0fdfe7d00"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RecoverSigner([]byte(tt.message), common.FromHex(tt.sig))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Hex() != signer {
+				t.Errorf("RecoverSigner() = %s, want %s", got.Hex(), signer)
+			}
+		})
+	}
+
+	// a signature of another message recovers another address
+	got, err := RecoverSigner([]byte("Other data"), common.FromHex(tests[0].sig))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Hex() == signer {
+		t.Errorf("RecoverSigner() of another message = %s, want another signer", got.Hex())
+	}
+}
+
+func TestRecoverSignerInvalidSignatures(t *testing.T) {
+	sig := common.FromHex("0xb91467e570a6466aa9e9876cbcd013baba02900b8979d43fe208a4a4f339f5fd6007e74cd82e037b800186422fc2da167c747ef045e5d18a5f5d4300f8e1a0291c")
+
+	// the malleable form of the signature: S in the upper half of the curve order and the other recovery ID
+	highS := append([]byte(nil), sig...)
+	s := new(big.Int).Sub(crypto.S256().Params().N, new(big.Int).SetBytes(sig[32:64]))
+	s.FillBytes(highS[32:64])
+	highS[64] = 27
+
+	withV := func(v byte) []byte {
+		b := append([]byte(nil), sig...)
+		b[64] = v
+		return b
+	}
+	tests := []struct {
+		name string
+		sig  []byte
+	}{
+		{"short", sig[:64]},
+		{"long", append(append([]byte(nil), sig...), 0)},
+		{"recovery ID 2", withV(2)},
+		{"recovery ID 29", withV(29)},
+		{"malleable", highS},
+		{"zero R and S", make([]byte, signatureLen)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, err := RecoverSigner([]byte("Some data"), tt.sig); err == nil {
+				t.Errorf("RecoverSigner() = %s, want an error", got.Hex())
+			}
+		})
+	}
+}
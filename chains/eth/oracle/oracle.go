@@ -0,0 +1,134 @@
+// Package oracle provides USD price oracles for Ether.
+package oracle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/shopspring/decimal"
+)
+
+// PriceOracle provides the price of one Ether in USD.
+type PriceOracle interface {
+	PriceUSD(ctx context.Context) (decimal.Decimal, error)
+}
+
+var (
+	// ErrStalePrice is returned when the latest price is older than the accepted maximum age.
+	ErrStalePrice = errors.New("stale price")
+
+	// function selectors of the Chainlink aggregator interface
+	selectorDecimals        = []byte{0x31, 0x3c, 0xe5, 0x67} // decimals()
+	selectorLatestRoundData = []byte{0xfe, 0xaf, 0x96, 0x8c} // latestRoundData()
+)
+
+const wordLen = 32
+
+// Chainlink is a PriceOracle reading a Chainlink ETH/USD aggregator contract.
+type Chainlink struct {
+	client ethereum.ContractCaller
+	feed   common.Address
+	maxAge time.Duration
+
+	// now returns the current time, it can be replaced in tests
+	now func() time.Time
+
+	mu       sync.Mutex
+	decimals int32
+	fetched  bool
+}
+
+// NewChainlink creates a Chainlink oracle reading the aggregator at the feed address.
+//
+// Parameters:
+// - client: the client used to call the aggregator, e.g. an *ethclient.Client.
+// - feed: the address of the aggregator contract.
+// - maxAge: the maximum age of an accepted price.
+//
+// Returns:
+// - *Chainlink: the new oracle.
+// - error: an error if the feed address is invalid.
+func NewChainlink(client ethereum.ContractCaller, feed string, maxAge time.Duration) (*Chainlink, error) {
+	if !common.IsHexAddress(feed) {
+		return nil, errors.New("invalid feed address")
+	}
+
+	return &Chainlink{
+		client: client,
+		feed:   common.HexToAddress(feed),
+		maxAge: maxAge,
+		now:    time.Now,
+	}, nil
+}
+
+// PriceUSD returns the latest price reported by the aggregator, adjusted for the feed decimals.
+//
+// It fails with ErrStalePrice when the price was last updated more than the maximum age ago.
+func (c *Chainlink) PriceUSD(ctx context.Context) (decimal.Decimal, error) {
+	decimals, err := c.feedDecimals(ctx)
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+
+	out, err := c.call(ctx, selectorLatestRoundData, 5)
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("failed to get latest round data: %w", err)
+	}
+
+	answer := math.S256(new(big.Int).SetBytes(word(out, 1)))
+	updatedAt := new(big.Int).SetBytes(word(out, 3))
+	if answer.Sign() <= 0 {
+		return decimal.Decimal{}, fmt.Errorf("invalid price %s", answer)
+	}
+	if !updatedAt.IsInt64() {
+		return decimal.Decimal{}, fmt.Errorf("invalid update time %s", updatedAt)
+	}
+
+	age := c.now().Sub(time.Unix(updatedAt.Int64(), 0))
+	if age > c.maxAge {
+		return decimal.Decimal{}, fmt.Errorf("%w: updated %s ago", ErrStalePrice, age.Truncate(time.Second))
+	}
+	return decimal.NewFromBigInt(answer, -decimals), nil
+}
+
+// feedDecimals returns the number of decimals of the feed answers, fetching it once.
+func (c *Chainlink) feedDecimals(ctx context.Context) (int32, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.fetched {
+		out, err := c.call(ctx, selectorDecimals, 1)
+		if err != nil {
+			return 0, fmt.Errorf("failed to get feed decimals: %w", err)
+		}
+		decimals := new(big.Int).SetBytes(word(out, 0))
+		if !decimals.IsInt64() || decimals.Int64() > 255 {
+			return 0, fmt.Errorf("invalid feed decimals %s", decimals)
+		}
+		c.decimals, c.fetched = int32(decimals.Int64()), true
+	}
+	return c.decimals, nil
+}
+
+// call calls the feed with the given selector, checking the result has at least the given number of words.
+func (c *Chainlink) call(ctx context.Context, selector []byte, words int) ([]byte, error) {
+	out, err := c.client.CallContract(ctx, ethereum.CallMsg{To: &c.feed, Data: selector}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(out) < words*wordLen {
+		return nil, fmt.Errorf("unexpected result length %d", len(out))
+	}
+	return out, nil
+}
+
+func word(b []byte, i int) []byte {
+	return b[i*wordLen : (i+1)*wordLen]
+}
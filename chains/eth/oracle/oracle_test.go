@@ -0,0 +1,126 @@
+package oracle
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+)
+
+const feedAddress = "0x5f4eC3Df9cbd43714FE2740f5E3616155c5b8419"
+
+// fakeFeed is a ContractCaller answering like a Chainlink aggregator.
+type fakeFeed struct {
+	decimals  int64
+	answer    *big.Int
+	updatedAt time.Time
+
+	decimalsCalls int
+}
+
+func (f *fakeFeed) CallContract(_ context.Context, msg ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	if *msg.To != common.HexToAddress(feedAddress) {
+		return nil, errors.New("unexpected contract")
+	}
+
+	switch {
+	case bytes.Equal(msg.Data, selectorDecimals):
+		f.decimalsCalls++
+		return words(big.NewInt(f.decimals)), nil
+	case bytes.Equal(msg.Data, selectorLatestRoundData):
+		// roundId, answer, startedAt, updatedAt, answeredInRound
+		updatedAt := big.NewInt(f.updatedAt.Unix())
+		return words(big.NewInt(1), math.U256(new(big.Int).Set(f.answer)), updatedAt, updatedAt, big.NewInt(1)), nil
+	}
+	return nil, errors.New("unexpected selector")
+}
+
+// words ABI-encodes the values as consecutive 32-byte words.
+func words(values ...*big.Int) []byte {
+	var out []byte
+	for _, v := range values {
+		out = append(out, math.U256Bytes(v)...)
+	}
+	return out
+}
+
+func TestChainlinkPriceUSD(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	tests := []struct {
+		name    string
+		answer  int64
+		age     time.Duration
+		want    string
+		wantErr error
+	}{
+		{"fresh round", 345_678_000_000, 10 * time.Minute, "3456.78", nil},
+		{"at the maximum age", 345_678_000_000, time.Hour, "3456.78", nil},
+		{"stale round", 345_678_000_000, time.Hour + time.Second, "", ErrStalePrice},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			feed := &fakeFeed{decimals: 8, answer: big.NewInt(tt.answer), updatedAt: now.Add(-tt.age)}
+			oracle, err := NewChainlink(feed, feedAddress, time.Hour)
+			if err != nil {
+				t.Fatal(err)
+			}
+			oracle.now = func() time.Time { return now }
+
+			got, err := oracle.PriceUSD(context.Background())
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("PriceUSD() = %s, %v, want %v", got, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil || got.String() != tt.want {
+				t.Errorf("PriceUSD() = %s, %v, want %s", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestChainlinkFetchesDecimalsOnce(t *testing.T) {
+	now := time.Now()
+	feed := &fakeFeed{decimals: 8, answer: big.NewInt(100_000_000), updatedAt: now}
+	oracle, err := NewChainlink(feed, feedAddress, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	oracle.now = func() time.Time { return now }
+
+	for range 3 {
+		if _, err := oracle.PriceUSD(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if feed.decimalsCalls != 1 {
+		t.Errorf("decimals() called %d times, want once", feed.decimalsCalls)
+	}
+}
+
+func TestChainlinkInvalidPrice(t *testing.T) {
+	now := time.Now()
+	for _, answer := range []int64{0, -1} {
+		feed := &fakeFeed{decimals: 8, answer: big.NewInt(answer), updatedAt: now}
+		oracle, err := NewChainlink(feed, feedAddress, time.Hour)
+		if err != nil {
+			t.Fatal(err)
+		}
+		oracle.now = func() time.Time { return now }
+
+		if got, err := oracle.PriceUSD(context.Background()); err == nil {
+			t.Errorf("PriceUSD() with answer %d = %s, want an error", answer, got)
+		}
+	}
+
+	if _, err := NewChainlink(&fakeFeed{}, "0x1234", time.Hour); err == nil {
+		t.Errorf("NewChainlink() with an invalid address succeeded, want an error")
+	}
+}
@@ -0,0 +1,139 @@
+package eth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// CodeReader reads the code of accounts, e.g. an *ethclient.Client or a *ChainClient.
+//
+// Helpers accept it rather than a concrete client so that tests can inject fakes.
+type CodeReader interface {
+	CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error)
+}
+
+// BalanceReader reads the balance of accounts, e.g. an *ethclient.Client or a *ChainClient.
+type BalanceReader interface {
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+}
+
+// ContractCaller executes message calls, e.g. an *ethclient.Client or a *ChainClient.
+type ContractCaller = ethereum.ContractCaller
+
+var (
+	_ CodeReader     = (*ChainClient)(nil)
+	_ BalanceReader  = (*ChainClient)(nil)
+	_ ContractCaller = (*ChainClient)(nil)
+)
+
+// BalanceOf returns the balance of the address at the given block, the latest block if nil.
+//
+// Parameters:
+// - ctx: the context of the call.
+// - address: the address to get the balance of.
+// - client: the client used to read the balance.
+// - blockNumber: the block to read the balance at, nil for the latest block.
+//
+// Returns:
+// - *Eth: the balance of the address.
+// - error: ErrInvalidAddress if the address is invalid, or the error of the client.
+func BalanceOf(ctx context.Context, address string, client BalanceReader, blockNumber *big.Int) (*Eth, error) {
+	addr, err := ParseAddress(address)
+	if err != nil {
+		return nil, err
+	}
+
+	wei, err := client.BalanceAt(ctx, addr, blockNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get balance: %w", err)
+	}
+	return NewEthFromWei(wei), nil
+}
+
+// maxConcurrentBalances bounds the number of balance calls BalancesOf has in flight.
+const maxConcurrentBalances = 16
+
+// BalancesOf returns the balances of many addresses at the given block, the latest block if nil.
+//
+// All addresses are validated before any call is made, and the invalid ones are skipped. The balances are then
+// fetched concurrently, with at most 16 calls in flight, and a failed call doesn't prevent fetching the other balances.
+// Once ctx is done, no more calls are started and the balances not fetched yet fail with the error of ctx.
+//
+// Parameters:
+// - ctx: the context of the calls.
+// - addresses: the addresses to get the balances of, duplicates being fetched once.
+// - client: the client used to read the balances.
+// - blockNumber: the block to read the balances at, nil for the latest block.
+//
+// Returns:
+// - map[string]*Eth: the balances by address, as given in addresses, of the calls that succeeded.
+// - error: the errors of the invalid addresses, wrapping ErrInvalidAddress, and then of the failed calls,
+// joined with errors.Join and each naming its address.
+func BalancesOf(ctx context.Context, addresses []string, client BalanceReader, blockNumber *big.Int) (map[string]*Eth, error) {
+	parsed := make(map[string]common.Address, len(addresses))
+	var errs []error
+	for _, address := range addresses {
+		addr, err := ParseAddress(address)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", address, err))
+			continue
+		}
+		parsed[address] = addr
+	}
+
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		sem      = make(chan struct{}, maxConcurrentBalances)
+		balances = make(map[string]*Eth, len(parsed))
+	)
+	fail := func(address string, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		errs = append(errs, fmt.Errorf("%s: failed to get balance: %w", address, err))
+	}
+	for address, addr := range parsed {
+		if err := acquire(ctx, sem); err != nil {
+			fail(address, err)
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			wei, err := client.BalanceAt(ctx, addr, blockNumber)
+			if err != nil {
+				fail(address, err)
+				return
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			balances[address] = NewEthFromWei(wei)
+		}()
+	}
+	wg.Wait()
+
+	return balances, errors.Join(errs...)
+}
+
+// acquire takes a slot of the semaphore, unless ctx is done first.
+func acquire(ctx context.Context, sem chan struct{}) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	select {
+	case sem <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
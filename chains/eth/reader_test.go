@@ -0,0 +1,89 @@
+package eth
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// balanceReader is a fake BalanceReader answering with the last byte of the address as balance,
+// failing for the addresses in fail, and counting its calls.
+type balanceReader struct {
+	fail  map[common.Address]bool
+	calls atomic.Int32
+	// block, if set, makes every call wait for it or for the end of the context
+	block chan struct{}
+}
+
+func (r *balanceReader) BalanceAt(ctx context.Context, account common.Address, _ *big.Int) (*big.Int, error) {
+	r.calls.Add(1)
+	if r.block != nil {
+		select {
+		case <-r.block:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	if r.fail[account] {
+		return nil, errors.New("node error")
+	}
+	return big.NewInt(int64(account[len(account)-1])), nil
+}
+
+func TestBalancesOf(t *testing.T) {
+	const (
+		first   = "0x0000000000000000000000000000000000000001"
+		second  = "0x0000000000000000000000000000000000000002"
+		failing = "0x0000000000000000000000000000000000000003"
+		invalid = "0x1234"
+	)
+	reader := &balanceReader{fail: map[common.Address]bool{common.HexToAddress(failing): true}}
+
+	balances, err := BalancesOf(context.Background(), []string{first, invalid, second, first, failing}, reader, nil)
+	if !errors.Is(err, ErrInvalidAddress) {
+		t.Errorf("error = %v, want ErrInvalidAddress", err)
+	}
+	for _, address := range []string{invalid, failing} {
+		if err == nil || !strings.Contains(err.Error(), address) {
+			t.Errorf("error = %v, want it to name %s", err, address)
+		}
+	}
+	if len(balances) != 2 || balances[first].Wei().Int64() != 1 || balances[second].Wei().Int64() != 2 {
+		t.Errorf("balances = %v, want the balances of the valid addresses", balances)
+	}
+	// the invalid address is skipped and the duplicate fetched once
+	if got := reader.calls.Load(); got != 3 {
+		t.Errorf("%d calls, want 3", got)
+	}
+}
+
+func TestBalancesOfStopsWhenContextIsDone(t *testing.T) {
+	addresses := make([]string, 4*maxConcurrentBalances)
+	for i := range addresses {
+		addresses[i] = common.BigToAddress(big.NewInt(int64(i + 1))).Hex()
+	}
+	reader := &balanceReader{block: make(chan struct{})}
+	defer close(reader.block)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	returnsWithin(t, 5*time.Second, func() {
+		balances, err := BalancesOf(ctx, addresses, reader, nil)
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("error = %v, want context.DeadlineExceeded", err)
+		}
+		if len(balances) != 0 {
+			t.Errorf("%d balances, want none", len(balances))
+		}
+	})
+	if got := reader.calls.Load(); got != maxConcurrentBalances {
+		t.Errorf("%d calls, want %d, the calls in flight when the context ended", got, maxConcurrentBalances)
+	}
+}
@@ -0,0 +1,246 @@
+package eth
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how failed calls are retried.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	// Values below 2 disable retries.
+	MaxAttempts int
+
+	// BaseDelay is the delay before the first retry, doubled after each retry.
+	BaseDelay time.Duration
+
+	// MaxDelay caps the delay between two attempts, if positive.
+	MaxDelay time.Duration
+
+	// Jitter returns a random duration in [0, d).
+	// It defaults to a uniform random duration, set it to make retries deterministic.
+	Jitter func(d time.Duration) time.Duration
+}
+
+// DefaultRetryPolicy returns the retry policy used by Dial unless configured otherwise.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 3,
+		BaseDelay:   defaultBackoff,
+		MaxDelay:    10 * time.Second,
+	}
+}
+
+// delay returns the delay before the given retry, starting from 0.
+//
+// Half of the exponential delay is fixed and the other half is jittered,
+// so that clients failing together don't retry together.
+func (p RetryPolicy) delay(retry int) time.Duration {
+	d := p.BaseDelay
+	for i := 0; i < retry && (p.MaxDelay <= 0 || d < p.MaxDelay); i++ {
+		d *= 2
+	}
+	if p.MaxDelay > 0 && d > p.MaxDelay {
+		d = p.MaxDelay
+	}
+
+	half := d / 2
+	if half <= 0 {
+		return d
+	}
+	jitter := p.Jitter
+	if jitter == nil {
+		jitter = func(d time.Duration) time.Duration {
+			return time.Duration(rand.Int63n(int64(d)))
+		}
+	}
+	return half + jitter(d-half)
+}
+
+// next returns the delay before the given retry, which is at least minDelay.
+//
+// It returns false if the context deadline doesn't leave enough time for the delay.
+func (p RetryPolicy) next(ctx context.Context, retry int, minDelay time.Duration) (time.Duration, bool) {
+	d := max(p.delay(retry), minDelay)
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < d {
+		return 0, false
+	}
+	return d, true
+}
+
+// sleep waits for d, returning false if the context ends first.
+func sleep(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// readMethods are the JSON-RPC methods that don't change state and are safe to retry.
+var readMethods = map[string]bool{
+	"eth_blockNumber":           true,
+	"eth_call":                  true,
+	"eth_chainId":               true,
+	"eth_estimateGas":           true,
+	"eth_feeHistory":            true,
+	"eth_gasPrice":              true,
+	"eth_getBalance":            true,
+	"eth_getBlockByHash":        true,
+	"eth_getBlockByNumber":      true,
+	"eth_getCode":               true,
+	"eth_getLogs":               true,
+	"eth_getStorageAt":          true,
+	"eth_getTransactionByHash":  true,
+	"eth_getTransactionCount":   true,
+	"eth_getTransactionReceipt": true,
+	"eth_maxPriorityFeePerGas":  true,
+	"net_version":               true,
+}
+
+// retryTransport retries JSON-RPC read calls failing with a transient error.
+//
+// Transient errors are network timeouts and the HTTP statuses providers use for
+// rate limiting and overload. Errors returned by the node itself, such as reverted calls,
+// come with a successful HTTP status and are never retried.
+type retryTransport struct {
+	base    http.RoundTripper
+	policy  RetryPolicy
+	timeout time.Duration
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	attempts := t.policy.MaxAttempts
+	if !isReadRequest(body) {
+		attempts = 1
+	}
+
+	for attempt := 1; ; attempt++ {
+		resp, err := t.try(req, body)
+		if attempt >= attempts || !isTransient(resp, err) {
+			return resp, err
+		}
+
+		var retryAfter time.Duration
+		if resp != nil {
+			retryAfter = parseRetryAfter(resp.Header.Get("Retry-After"))
+		}
+		delay, ok := t.policy.next(req.Context(), attempt-1, retryAfter)
+		if !ok {
+			// no time left for another attempt, report the last failure as is
+			return resp, err
+		}
+
+		if resp != nil {
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		if !sleep(req.Context(), delay) {
+			return nil, req.Context().Err()
+		}
+	}
+}
+
+// try sends a single attempt of the request, bounded by the transport timeout.
+func (t *retryTransport) try(req *http.Request, body []byte) (*http.Response, error) {
+	ctx, cancel := req.Context(), context.CancelFunc(func() {})
+	if t.timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, t.timeout)
+	}
+
+	r := req.Clone(ctx)
+	if body != nil {
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		r.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(body)), nil
+		}
+	}
+
+	resp, err := t.base.RoundTrip(r)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp.Body = &cancelBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// cancelBody releases the attempt context once the response body is closed.
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelBody) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
+
+// isReadRequest checks if the JSON-RPC request, or every request of a batch, is a read call.
+func isReadRequest(body []byte) bool {
+	type call struct {
+		Method string `json:"method"`
+	}
+
+	var calls []call
+	if err := json.Unmarshal(body, &calls); err != nil {
+		var c call
+		if err := json.Unmarshal(body, &c); err != nil {
+			return false
+		}
+		calls = []call{c}
+	}
+
+	for _, c := range calls {
+		if !readMethods[c.Method] {
+			return false
+		}
+	}
+	return len(calls) > 0
+}
+
+func isTransient(resp *http.Response, err error) bool {
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return false
+		}
+		var netErr net.Error
+		return errors.Is(err, context.DeadlineExceeded) || errors.As(err, &netErr) && netErr.Timeout()
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// parseRetryAfter parses a Retry-After header expressed in seconds.
+func parseRetryAfter(header string) time.Duration {
+	seconds, err := strconv.Atoi(header)
+	if err != nil || seconds < 0 {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
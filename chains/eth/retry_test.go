@@ -0,0 +1,190 @@
+package eth
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// stubTransport is an http.RoundTripper answering every attempt with respond, counting the attempts.
+type stubTransport struct {
+	attempts atomic.Int32
+	respond  func(req *http.Request, attempt int) (*http.Response, error)
+}
+
+func (s *stubTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return s.respond(req, int(s.attempts.Add(1)))
+}
+
+func stubResponse(status int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: status,
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+}
+
+// hang blocks until the request is canceled, like a node that never answers.
+func hang(req *http.Request, _ int) (*http.Response, error) {
+	<-req.Context().Done()
+	return nil, req.Context().Err()
+}
+
+// noJitter makes the retry delays deterministic.
+func noJitter(time.Duration) time.Duration { return 0 }
+
+const (
+	readCall  = `{"jsonrpc":"2.0","id":1,"method":"eth_getBalance","params":[]}`
+	writeCall = `{"jsonrpc":"2.0","id":1,"method":"eth_sendRawTransaction","params":[]}`
+)
+
+func roundTrip(t *testing.T, ctx context.Context, rt http.RoundTripper, body string) (*http.Response, error) {
+	t.Helper()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "http://node.invalid", strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := rt.RoundTrip(req)
+	if resp != nil {
+		t.Cleanup(func() { resp.Body.Close() })
+	}
+	return resp, err
+}
+
+func TestRetryTransportRetriesTransientReads(t *testing.T) {
+	stub := &stubTransport{respond: func(req *http.Request, attempt int) (*http.Response, error) {
+		body, _ := io.ReadAll(req.Body)
+		if string(body) != readCall {
+			t.Errorf("attempt %d sent %q, want %q", attempt, body, readCall)
+		}
+		if attempt < 3 {
+			return stubResponse(http.StatusServiceUnavailable, ""), nil
+		}
+		return stubResponse(http.StatusOK, `{"jsonrpc":"2.0","id":1,"result":"0x1"}`), nil
+	}}
+	rt := &retryTransport{base: stub, policy: RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, Jitter: noJitter}}
+
+	resp, err := roundTrip(t, context.Background(), rt, readCall)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("status = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	if got := stub.attempts.Load(); got != 3 {
+		t.Errorf("%d attempts, want 3", got)
+	}
+}
+
+func TestRetryTransportDoesNotRetry(t *testing.T) {
+	tests := []struct {
+		name   string
+		body   string
+		status int
+	}{
+		{"write call", writeCall, http.StatusServiceUnavailable},
+		{"batch with a write call", "[" + readCall + "," + writeCall + "]", http.StatusServiceUnavailable},
+		{"permanent status", readCall, http.StatusBadRequest},
+		{"node error", readCall, http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stub := &stubTransport{respond: func(*http.Request, int) (*http.Response, error) {
+				return stubResponse(tt.status, ""), nil
+			}}
+			rt := &retryTransport{base: stub, policy: RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, Jitter: noJitter}}
+
+			resp, err := roundTrip(t, context.Background(), rt, tt.body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.StatusCode != tt.status {
+				t.Errorf("status = %d, want %d", resp.StatusCode, tt.status)
+			}
+			if got := stub.attempts.Load(); got != 1 {
+				t.Errorf("%d attempts, want 1", got)
+			}
+		})
+	}
+}
+
+func TestRetryTransportTimesOutSlowAttempts(t *testing.T) {
+	stub := &stubTransport{respond: hang}
+	rt := &retryTransport{
+		base:    stub,
+		policy:  RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond, Jitter: noJitter},
+		timeout: 20 * time.Millisecond,
+	}
+
+	start := time.Now()
+	_, err := roundTrip(t, context.Background(), rt, readCall)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("error = %v, want context.DeadlineExceeded", err)
+	}
+	if got := stub.attempts.Load(); got != 2 {
+		t.Errorf("%d attempts, want 2", got)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("took %s, want each attempt bounded by the timeout", elapsed)
+	}
+}
+
+func TestRetryTransportStopsWhenContextIsCanceled(t *testing.T) {
+	stub := &stubTransport{respond: func(*http.Request, int) (*http.Response, error) {
+		return stubResponse(http.StatusServiceUnavailable, ""), nil
+	}}
+	rt := &retryTransport{base: stub, policy: RetryPolicy{MaxAttempts: 10, BaseDelay: time.Hour, Jitter: noJitter}}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(20*time.Millisecond, cancel)
+
+	start := time.Now()
+	_, err := roundTrip(t, ctx, rt, readCall)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("error = %v, want context.Canceled", err)
+	}
+	if got := stub.attempts.Load(); got != 1 {
+		t.Errorf("%d attempts, want 1", got)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("took %s, want to return on cancellation", elapsed)
+	}
+}
+
+func TestRetryTransportStopsBeforeDeadline(t *testing.T) {
+	stub := &stubTransport{respond: func(*http.Request, int) (*http.Response, error) {
+		resp := stubResponse(http.StatusTooManyRequests, "")
+		resp.Header.Set("Retry-After", "60")
+		return resp, nil
+	}}
+	rt := &retryTransport{base: stub, policy: RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, Jitter: noJitter}}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	// waiting the 60s asked by Retry-After would exceed the deadline, so the last response is returned right away
+	resp, err := roundTrip(t, ctx, rt, readCall)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("status = %d, want %d", resp.StatusCode, http.StatusTooManyRequests)
+	}
+	if got := stub.attempts.Load(); got != 1 {
+		t.Errorf("%d attempts, want 1", got)
+	}
+}
+
+func TestRetryPolicyDelay(t *testing.T) {
+	p := RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second, Jitter: noJitter}
+	for retry, want := range []time.Duration{50, 100, 200, 400, 500, 500} {
+		if got := p.delay(retry); got != want*time.Millisecond {
+			t.Errorf("delay(%d) = %s, want %s", retry, got, want*time.Millisecond)
+		}
+	}
+}
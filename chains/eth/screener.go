@@ -0,0 +1,69 @@
+package eth
+
+import "fmt"
+
+// Screener screens addresses against an allowlist and a denylist.
+//
+// Addresses are compared in their EIP-55 checksummed form, so differences in case never matter.
+type Screener struct {
+	allow map[string]struct{}
+	deny  map[string]struct{}
+}
+
+// NewScreener creates a Screener from the given allowlist and denylist.
+//
+// An empty allowlist allows every address that isn't denied.
+//
+// Returns:
+// - *Screener: the new Screener.
+// - error: an error if any of the listed addresses is invalid.
+func NewScreener(allow, deny []string) (*Screener, error) {
+	allowSet, err := addressSet(allow)
+	if err != nil {
+		return nil, fmt.Errorf("invalid allowlist: %w", err)
+	}
+	denySet, err := addressSet(deny)
+	if err != nil {
+		return nil, fmt.Errorf("invalid denylist: %w", err)
+	}
+
+	return &Screener{
+		allow: allowSet,
+		deny:  denySet,
+	}, nil
+}
+
+// Allowed checks if the address passes screening.
+//
+// Denied addresses are never allowed, even when they are also on the allowlist.
+//
+// Returns:
+// - bool: true if the address is allowed, false otherwise.
+// - error: an error if the address is invalid.
+func (s *Screener) Allowed(address string) (bool, error) {
+	addr, err := ChecksumAddress(address)
+	if err != nil {
+		return false, err
+	}
+
+	if _, ok := s.deny[addr]; ok {
+		return false, nil
+	}
+	if len(s.allow) == 0 {
+		return true, nil
+	}
+	_, ok := s.allow[addr]
+	return ok, nil
+}
+
+func addressSet(addresses []string) (map[string]struct{}, error) {
+	set := make(map[string]struct{}, len(addresses))
+	for _, address := range addresses {
+		addr, err := ChecksumAddress(address)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", address, err)
+		}
+		set[addr] = struct{}{}
+	}
+	return set, nil
+}
@@ -0,0 +1,41 @@
+package eth
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestScreenerAllowed(t *testing.T) {
+	// EIP-55 vectors
+	const allowed, denied = "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359"
+
+	s, err := NewScreener([]string{strings.ToLower(allowed), denied}, []string{"0x" + strings.ToUpper(denied[2:])})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		address string
+		want    bool
+	}{
+		{allowed, true},
+		{strings.ToLower(allowed), true},
+		{"0x" + strings.ToUpper(allowed[2:]), true},
+		{denied, false},
+		{strings.ToLower(denied), false},
+		{"0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB", false},
+	}
+	for _, tt := range tests {
+		got, err := s.Allowed(tt.address)
+		if err != nil || got != tt.want {
+			t.Errorf("Allowed(%s) = %t, %v, want %t", tt.address, got, err, tt.want)
+		}
+	}
+
+	if _, err := s.Allowed("0x1234"); err == nil {
+		t.Errorf("Allowed(0x1234) succeeded, want an error")
+	}
+	if _, err := NewScreener(nil, []string{"0x1234"}); err == nil {
+		t.Errorf("NewScreener() with an invalid address succeeded, want an error")
+	}
+}
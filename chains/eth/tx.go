@@ -0,0 +1,131 @@
+package eth
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+var storageKeyRegex = regexp.MustCompile("^0x[0-9a-fA-F]{64}$")
+
+// AccessTuple is an entry of an EIP-2930 access list, an address and the storage slots of it accessed by a transaction.
+type AccessTuple struct {
+	// Address is the 0x prefixed hex address of the account.
+	Address string
+	// StorageKeys are the 0x prefixed 32 bytes hex keys of the storage slots.
+	StorageKeys []string
+}
+
+// Tx is an unsigned transaction to build for signing.
+//
+// Transactions with an access list are EIP-2930 (type 1) transactions,
+// the others are legacy transactions replay protected according to EIP-155.
+type Tx struct {
+	ChainID  *big.Int
+	Nonce    uint64
+	GasPrice *Eth
+	GasLimit uint64
+	// To is the 0x prefixed hex address of the recipient, empty for a contract creation.
+	To    string
+	Value *Eth
+	Data  []byte
+	// AccessList is the EIP-2930 access list, a non-nil access list making a type 1 transaction even if empty.
+	AccessList []AccessTuple
+}
+
+// Build validates the transaction and returns its go-ethereum form.
+//
+// Returns:
+// - *gethtypes.Transaction: the unsigned transaction.
+// - error: an error if a field is missing or invalid.
+func (t Tx) Build() (*gethtypes.Transaction, error) {
+	if t.ChainID == nil || t.ChainID.Sign() <= 0 {
+		return nil, errors.New("missing chain ID")
+	}
+	if t.GasPrice == nil || t.GasPrice.Units().Sign() < 0 {
+		return nil, errors.New("missing or negative gas price")
+	}
+
+	var to *common.Address
+	if t.To != "" {
+		addr, err := ParseAddress(t.To)
+		if err != nil {
+			return nil, fmt.Errorf("recipient: %w", err)
+		}
+		to = &addr
+	}
+	value := new(big.Int)
+	if t.Value != nil {
+		if t.Value.Units().Sign() < 0 {
+			return nil, errors.New("negative value")
+		}
+		value.Set(t.Value.Units())
+	}
+	gasPrice := new(big.Int).Set(t.GasPrice.Units())
+
+	if t.AccessList == nil {
+		return gethtypes.NewTx(&gethtypes.LegacyTx{
+			Nonce:    t.Nonce,
+			GasPrice: gasPrice,
+			Gas:      t.GasLimit,
+			To:       to,
+			Value:    value,
+			Data:     t.Data,
+		}), nil
+	}
+
+	accessList, err := buildAccessList(t.AccessList)
+	if err != nil {
+		return nil, err
+	}
+	return gethtypes.NewTx(&gethtypes.AccessListTx{
+		ChainID:    new(big.Int).Set(t.ChainID),
+		Nonce:      t.Nonce,
+		GasPrice:   gasPrice,
+		Gas:        t.GasLimit,
+		To:         to,
+		Value:      value,
+		Data:       t.Data,
+		AccessList: accessList,
+	}), nil
+}
+
+// SigningHash returns the hash of the transaction to sign.
+//
+// For type 1 transactions it is keccak256(0x01 || rlp([chainId, nonce, gasPrice, gasLimit, to, value, data, accessList])).
+//
+// Returns:
+// - common.Hash: the hash to sign.
+// - error: an error if the transaction is invalid, see Build.
+func (t Tx) SigningHash() (common.Hash, error) {
+	tx, err := t.Build()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return gethtypes.NewEIP2930Signer(t.ChainID).Hash(tx), nil
+}
+
+// buildAccessList validates the access list and returns its go-ethereum form.
+func buildAccessList(tuples []AccessTuple) (gethtypes.AccessList, error) {
+	accessList := make(gethtypes.AccessList, 0, len(tuples))
+	for i, tuple := range tuples {
+		addr, err := ParseAddress(tuple.Address)
+		if err != nil {
+			return nil, fmt.Errorf("access list entry %d: %w", i, err)
+		}
+
+		keys := make([]common.Hash, 0, len(tuple.StorageKeys))
+		for _, key := range tuple.StorageKeys {
+			if !storageKeyRegex.MatchString(key) {
+				return nil, fmt.Errorf("access list entry %d: invalid storage key %q", i, key)
+			}
+			keys = append(keys, common.HexToHash(key))
+		}
+		accessList = append(accessList, gethtypes.AccessTuple{Address: addr, StorageKeys: keys})
+	}
+	return accessList, nil
+}
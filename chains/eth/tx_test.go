@@ -0,0 +1,158 @@
+package eth
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+func TestTxSigningHash(t *testing.T) {
+	const to = "0x3535353535353535353535353535353535353535"
+	tests := []struct {
+		name string
+		tx   Tx
+		want string
+	}{
+		{
+			// the example of EIP-155
+			name: "legacy",
+			tx: Tx{
+				ChainID:  big.NewInt(1),
+				Nonce:    9,
+				GasPrice: NewEthFromWei(big.NewInt(20_000_000_000)),
+				GasLimit: 21000,
+				To:       to,
+				Value:    NewEthFromWei(big.NewInt(1_000_000_000_000_000_000)),
+			},
+			want: "0xdaf5a779ae972f972197303d7b574746c7ef83eadac0f2791ad23db92e4c8e53",
+		},
+		{
+			name: "access list",
+			tx: Tx{
+				ChainID:  big.NewInt(1),
+				Nonce:    9,
+				GasPrice: NewEthFromWei(big.NewInt(20_000_000_000)),
+				GasLimit: 30000,
+				To:       to,
+				Value:    NewEthFromWei(big.NewInt(1_000_000_000_000_000_000)),
+				AccessList: []AccessTuple{{
+					Address: to,
+					StorageKeys: []string{
+						"0x0000000000000000000000000000000000000000000000000000000000000000",
+						"0x0000000000000000000000000000000000000000000000000000000000000001",
+					},
+				}},
+			},
+			want: "0xc84a67cac98daf89bc726af3b66f3c23c42dc5eef75acffea2a66c2f0e374e00",
+		},
+		{
+			name: "empty access list",
+			tx: Tx{
+				ChainID:    big.NewInt(1),
+				Nonce:      9,
+				GasPrice:   NewEthFromWei(big.NewInt(20_000_000_000)),
+				GasLimit:   30000,
+				To:         to,
+				Value:      NewEthFromWei(big.NewInt(1_000_000_000_000_000_000)),
+				AccessList: []AccessTuple{},
+			},
+			want: "0x50a63ec95eeb62ba7b32f8a7713691f6d1fa52a09b63b9f659491750ae3c445c",
+		},
+		{
+			name: "contract creation",
+			tx: Tx{
+				ChainID:    big.NewInt(5),
+				GasPrice:   NewEthFromWei(big.NewInt(1_000_000_000)),
+				GasLimit:   100000,
+				Data:       common.FromHex("0x6001600055"),
+				AccessList: []AccessTuple{},
+			},
+			want: "0xafc14e7eb86e6038f39b951c691635dea997bc593ecc4228e68b8e62b422fd4e",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.tx.SigningHash()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Hex() != tt.want {
+				t.Errorf("SigningHash() = %s, want %s", got.Hex(), tt.want)
+			}
+		})
+	}
+}
+
+func TestTxSigningHashMatchesGeth(t *testing.T) {
+	chainID := big.NewInt(137)
+	to := common.HexToAddress("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed")
+	tx := Tx{
+		ChainID:  chainID,
+		Nonce:    42,
+		GasPrice: NewEthFromWei(big.NewInt(30_000_000_000)),
+		GasLimit: 60000,
+		To:       to.Hex(),
+		Value:    NewEthFromWei(big.NewInt(12345)),
+		Data:     []byte{0xde, 0xad, 0xbe, 0xef},
+		AccessList: []AccessTuple{
+			{Address: to.Hex(), StorageKeys: []string{"0x00000000000000000000000000000000000000000000000000000000000000ff"}},
+			{Address: "0x0000000000000000000000000000000000000001"},
+		},
+	}
+	gethTx := gethtypes.NewTx(&gethtypes.AccessListTx{
+		ChainID:  chainID,
+		Nonce:    42,
+		GasPrice: big.NewInt(30_000_000_000),
+		Gas:      60000,
+		To:       &to,
+		Value:    big.NewInt(12345),
+		Data:     []byte{0xde, 0xad, 0xbe, 0xef},
+		AccessList: gethtypes.AccessList{
+			{Address: to, StorageKeys: []common.Hash{common.HexToHash("0xff")}},
+			{Address: common.HexToAddress("0x01"), StorageKeys: []common.Hash{}},
+		},
+	})
+
+	got, err := tx.SigningHash()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := gethtypes.NewEIP2930Signer(chainID).Hash(gethTx); got != want {
+		t.Errorf("SigningHash() = %s, want %s", got.Hex(), want.Hex())
+	}
+}
+
+func TestTxBuildErrors(t *testing.T) {
+	valid := func() Tx {
+		return Tx{ChainID: big.NewInt(1), GasPrice: NewEthFromWei(big.NewInt(1)), AccessList: []AccessTuple{}}
+	}
+	tests := []struct {
+		name   string
+		modify func(tx *Tx)
+	}{
+		{"missing chain ID", func(tx *Tx) { tx.ChainID = nil }},
+		{"zero chain ID", func(tx *Tx) { tx.ChainID = big.NewInt(0) }},
+		{"missing gas price", func(tx *Tx) { tx.GasPrice = nil }},
+		{"negative gas price", func(tx *Tx) { tx.GasPrice = NewEthFromWei(big.NewInt(-1)) }},
+		{"negative value", func(tx *Tx) { tx.Value = NewEthFromWei(big.NewInt(-1)) }},
+		{"invalid recipient", func(tx *Tx) { tx.To = "0x1234" }},
+		{"invalid access list address", func(tx *Tx) { tx.AccessList = []AccessTuple{{Address: "0x1234"}} }},
+		{"short storage key", func(tx *Tx) {
+			tx.AccessList = []AccessTuple{{Address: "0x0000000000000000000000000000000000000001", StorageKeys: []string{"0x01"}}}
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := valid()
+			tt.modify(&tx)
+			if _, err := tx.SigningHash(); err == nil {
+				t.Error("SigningHash() succeeded, want an error")
+			}
+		})
+	}
+	if _, err := valid().SigningHash(); err != nil {
+		t.Errorf("SigningHash() of the valid transaction: %v", err)
+	}
+}
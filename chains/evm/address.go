@@ -0,0 +1,45 @@
+// Package evm provides the address handling shared by all EVM chains,
+// whose addresses are 20 bytes hex strings checksummed according to EIP-55.
+package evm
+
+import (
+	"errors"
+	"regexp"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	// ErrInvalidAddress is returned when an address isn't a valid EVM address.
+	ErrInvalidAddress = errors.New("invalid address")
+
+	addrRegex = regexp.MustCompile("^0x[0-9a-fA-F]{40}$")
+)
+
+// Address is a 20 bytes EVM address, its Hex method returns its EIP-55 checksummed form.
+type Address = common.Address
+
+func IsValidAddress(address string) bool {
+	return addrRegex.MatchString(address)
+}
+
+// ParseAddress parses a 0x prefixed hex address.
+//
+// The address may be in any case; its own checksum, if any, is not verified.
+func ParseAddress(address string) (Address, error) {
+	if !IsValidAddress(address) {
+		return Address{}, ErrInvalidAddress
+	}
+	return common.HexToAddress(address), nil
+}
+
+// ChecksumAddress returns the EIP-55 checksummed form of the address.
+//
+// The address may be in any case; its own checksum, if any, is not verified.
+func ChecksumAddress(address string) (string, error) {
+	addr, err := ParseAddress(address)
+	if err != nil {
+		return "", err
+	}
+	return addr.Hex(), nil
+}
@@ -0,0 +1,74 @@
+package evm
+
+import (
+	"math/big"
+
+	"github.com/airsigner/libcrypto/types"
+)
+
+// Chain describes an EVM chain and its native coin, so that adding a chain only takes declaring it.
+//
+// Chain implements types.ValueDefinition, the definition of a chain package can delegate to it
+// and its coin type embed Coin, e.g.
+//
+//	var Chain = evm.NewChain("AVAX", 18, 43114)
+//
+//	type avaxDefinition struct{}
+//
+//	func (avaxDefinition) CoinName() string { return Chain.CoinName() }
+//	func (avaxDefinition) UnitExp() int32   { return Chain.UnitExp() }
+//
+//	type Avax struct {
+//		evm.Coin[avaxDefinition]
+//	}
+type Chain struct {
+	name     string
+	decimals int32
+	chainID  *big.Int
+}
+
+// NewChain creates the description of an EVM chain.
+//
+// Parameters:
+// - name: the name of the native coin, e.g. "AVAX".
+// - decimals: the number of decimals of the native coin, 18 for most chains.
+// - chainID: the EIP-155 chain ID, e.g. 43114 for the Avalanche C-Chain.
+//
+// Returns:
+// - Chain: the description of the chain.
+func NewChain(name string, decimals int32, chainID int64) Chain {
+	return Chain{
+		name:     name,
+		decimals: decimals,
+		chainID:  big.NewInt(chainID),
+	}
+}
+
+// CoinName returns the name of the native coin of the chain.
+func (c Chain) CoinName() string {
+	return c.name
+}
+
+// UnitExp returns the number of decimals of the native coin of the chain.
+func (c Chain) UnitExp() int32 {
+	return c.decimals
+}
+
+// Denominations returns the wei, the gwei and the native coin of the chain, see types.CoinValue.Humanize.
+func (c Chain) Denominations() []types.Denomination {
+	return []types.Denomination{
+		{Name: "wei", Exp: 0},
+		{Name: "gwei", Exp: GWeiExp},
+		{Name: c.name, Exp: c.decimals},
+	}
+}
+
+// ChainID returns the EIP-155 chain ID of the chain.
+func (c Chain) ChainID() *big.Int {
+	return new(big.Int).Set(c.chainID)
+}
+
+// IsValidAddress checks if the address is a valid address of the chain, see IsValidAddress.
+func (c Chain) IsValidAddress(address string) bool {
+	return IsValidAddress(address)
+}
@@ -0,0 +1,72 @@
+package evm
+
+import (
+	"math/big"
+
+	"github.com/airsigner/libcrypto/types"
+	"github.com/airsigner/libcrypto/types/pb"
+	"github.com/shopspring/decimal"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+// The exponents of the denominations of the native coins of EVM chains, relative to the wei.
+const (
+	KWeiExp int32 = 3
+	MWeiExp int32 = 6
+	GWeiExp int32 = 9
+)
+
+// Coin is an amount of the native coin of an EVM chain, held in wei.
+//
+// The coin types of the chain packages embed it to share its wei helpers, see Chain.
+type Coin[D types.ValueDefinition] struct {
+	*types.CoinValue[D]
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, see types.CoinValue.UnmarshalBinary.
+func (c *Coin[D]) UnmarshalBinary(data []byte) error {
+	return types.DecodeInto(&c.CoinValue, func(v *types.CoinValue[D]) error {
+		return v.UnmarshalBinary(data)
+	})
+}
+
+// FromProto sets the value to the value of its protobuf representation, see types.CoinValue.FromProto.
+func (c *Coin[D]) FromProto(m *pb.CoinValue) error {
+	return types.DecodeInto(&c.CoinValue, func(v *types.CoinValue[D]) error {
+		return v.FromProto(m)
+	})
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, see types.CoinValue.UnmarshalCBOR.
+func (c *Coin[D]) UnmarshalCBOR(data []byte) error {
+	return types.DecodeInto(&c.CoinValue, func(v *types.CoinValue[D]) error {
+		return v.UnmarshalCBOR(data)
+	})
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler, see types.CoinValue.UnmarshalBSONValue.
+func (c *Coin[D]) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	return types.DecodeInto(&c.CoinValue, func(v *types.CoinValue[D]) error {
+		return v.UnmarshalBSONValue(t, data)
+	})
+}
+
+// Wei returns the value of the Coin in wei.
+func (c Coin[D]) Wei() *big.Int {
+	return c.Units()
+}
+
+// KWei returns the value of the Coin in kwei.
+func (c Coin[D]) KWei() decimal.Decimal {
+	return c.ScaledValue(KWeiExp)
+}
+
+// MWei returns the value of the Coin in mwei.
+func (c Coin[D]) MWei() decimal.Decimal {
+	return c.ScaledValue(MWeiExp)
+}
+
+// GWei returns the value of the Coin in gwei.
+func (c Coin[D]) GWei() decimal.Decimal {
+	return c.ScaledValue(GWeiExp)
+}
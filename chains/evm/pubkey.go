@@ -0,0 +1,41 @@
+package evm
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// AddressFromPubKey returns the address of a secp256k1 public key,
+// the last 20 bytes of the Keccak-256 hash of its uncompressed encoding.
+func AddressFromPubKey(pub *ecdsa.PublicKey) Address {
+	return crypto.PubkeyToAddress(*pub)
+}
+
+// AddressFromPubKeyBytes returns the address of an encoded secp256k1 public key, see AddressFromPubKey.
+//
+// Parameters:
+// - pub: the public key, either compressed (33 bytes) or uncompressed (65 bytes, starting with 0x04).
+//
+// Returns:
+// - Address: the address of the public key.
+// - error: an error if the encoding is invalid or the point isn't on the curve.
+func AddressFromPubKeyBytes(pub []byte) (Address, error) {
+	var (
+		key *ecdsa.PublicKey
+		err error
+	)
+	switch len(pub) {
+	case 33:
+		key, err = crypto.DecompressPubkey(pub)
+	case 65:
+		key, err = crypto.UnmarshalPubkey(pub)
+	default:
+		return Address{}, fmt.Errorf("invalid public key length %d", len(pub))
+	}
+	if err != nil {
+		return Address{}, fmt.Errorf("invalid public key: %w", err)
+	}
+	return AddressFromPubKey(key), nil
+}
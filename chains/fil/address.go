@@ -0,0 +1,91 @@
+package fil
+
+import (
+	"bytes"
+	"encoding/base32"
+	"encoding/binary"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+// address protocols, the digit following the network prefix
+const (
+	protocolID        = '0' // f0..., an actor ID
+	protocolSecp256k1 = '1' // f1..., a hash of a secp256k1 public key
+	protocolActor     = '2' // f2..., a hash of the actor creation
+	protocolBLS       = '3' // f3..., a BLS public key
+	protocolDelegated = '4' // f4<namespace>f..., an address managed by the namespace actor, e.g. 10 for EVM
+
+	hashPayloadLen     = 20
+	blsPayloadLen      = 48
+	maxSubaddressLen   = 54
+	checksumLen        = 4
+	maxIDDigits        = 20 // digits of the largest uint64
+	delegatedSeparator = "f"
+)
+
+var encoding = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
+
+// IsValidAddress checks if the address is a valid Filecoin address, on mainnet (f...) or testnet (t...).
+//
+// The address is validated according to its protocol:
+// ID addresses (f0) must be a decimal uint64, secp256k1 (f1), actor (f2) and BLS (f3) addresses
+// must have a payload of the right length and a valid checksum, and delegated addresses (f4)
+// must have a valid namespace, a subaddress of at most 54 bytes and a valid checksum.
+func IsValidAddress(address string) bool {
+	if len(address) < 3 || (address[0] != 'f' && address[0] != 't') {
+		return false
+	}
+
+	protocol, raw := address[1], address[2:]
+	switch protocol {
+	case protocolID:
+		return isValidID(raw)
+	case protocolSecp256k1, protocolActor:
+		payload, ok := decodeChecked([]byte{protocol - '0'}, raw)
+		return ok && len(payload) == hashPayloadLen
+	case protocolBLS:
+		payload, ok := decodeChecked([]byte{protocol - '0'}, raw)
+		return ok && len(payload) == blsPayloadLen
+	case protocolDelegated:
+		namespace, raw, found := strings.Cut(raw, delegatedSeparator)
+		if !found || !isValidID(namespace) {
+			return false
+		}
+		id, _ := strconv.ParseUint(namespace, 10, 64)
+		payload, ok := decodeChecked(binary.AppendUvarint([]byte{protocol - '0'}, id), raw)
+		return ok && len(payload) <= maxSubaddressLen
+	default:
+		return false
+	}
+}
+
+// isValidID checks if s is the canonical decimal representation of a uint64.
+func isValidID(s string) bool {
+	if len(s) == 0 || len(s) > maxIDDigits || (len(s) > 1 && s[0] == '0') {
+		return false
+	}
+	_, err := strconv.ParseUint(s, 10, 64)
+	return err == nil
+}
+
+// decodeChecked decodes the base32 payload and checksum, verifying the checksum computed over
+// the prefix and the payload, and returns the payload.
+func decodeChecked(prefix []byte, s string) ([]byte, bool) {
+	b, err := encoding.DecodeString(s)
+	if err != nil || len(b) < checksumLen {
+		return nil, false
+	}
+	// reject non-canonical encodings, whose unused trailing bits aren't zero
+	if encoding.EncodeToString(b) != s {
+		return nil, false
+	}
+
+	payload, checksum := b[:len(b)-checksumLen], b[len(b)-checksumLen:]
+	h, _ := blake2b.New(checksumLen, nil)
+	h.Write(prefix)
+	h.Write(payload)
+	return payload, bytes.Equal(h.Sum(nil), checksum)
+}
@@ -0,0 +1,43 @@
+package fil
+
+import "testing"
+
+func TestIsValidAddress(t *testing.T) {
+	tests := []struct {
+		name    string
+		address string
+		valid   bool
+	}{
+		// examples of the Filecoin specification
+		{"id", "f01729", true},
+		{"id zero", "f00", true},
+		{"secp256k1", "f17uoq6tp427uzv7fztkbsnn64iwotfrristwpryy", true},
+		{"bls", "f3vvmn62lofvhjd2ugzca6sof2j2ubwok6cj4xxbfzz4yuxfkgobpihhd2thlanmsh3w2ptld2gqkn2jvlss4a", true},
+		// 0x52963EF50e27e06D72D59fcB4F3c2a687BE3cfEf in the EVM namespace
+		{"delegated", "f410fkkld55ioe7qg24wvt7fu6pbknb56ht7pt4zamxa", true},
+		{"testnet secp256k1", "t1fvyrmqvxe2yeialcpsu7xlbs6xefgd5ro3zsi3q", true},
+
+		{"id leading zero", "f001729", false},
+		{"id overflowing uint64", "f018446744073709551616", false},
+		{"id not a number", "f0abc", false},
+		{"truncated secp256k1", "f17uoq6tp427uzv7fztkbsnn64iwotfrristwpry", false},
+		{"secp256k1 bad checksum", "f17uoq6tp427uzv7fztkbsnn64iwotfrristwprya", false},
+		{"secp256k1 non-canonical trailing bits", "f17uoq6tp427uzv7fztkbsnn64iwotfrristwpryz", false},
+		{"secp256k1 short payload", "f1fvyrmqvxe2yeialcpsu7xlbs6xefgd5g2xr5g", false},
+		{"bls checksum on secp256k1 protocol", "f1vvmn62lofvhjd2ugzca6sof2j2ubwok6cj4xxbfzz4yuxfkgobpihhd2thlanmsh3w2ptld2gqkn2jvlss4a", false},
+		{"bls short payload", "f3fvyrmqvxe2yeialcpsu7xlbs6xefgd5rgjhtrkq", false},
+		{"delegated wrong namespace", "f411fkkld55ioe7qg24wvt7fu6pbknb56ht7pt4zamxa", false},
+		{"delegated without separator", "f410kkld55ioe7qg24wvt7fu6pbknb56ht7pt4zamxa", false},
+		{"uppercase", "F17UOQ6TP427UZV7FZTKBSNN64IWOTFRRISTWPRYY", false},
+		{"unknown network", "x17uoq6tp427uzv7fztkbsnn64iwotfrristwpryy", false},
+		{"unknown protocol", "f57uoq6tp427uzv7fztkbsnn64iwotfrristwpryy", false},
+		{"empty", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsValidAddress(tt.address); got != tt.valid {
+				t.Errorf("IsValidAddress(%q) = %v, want %v", tt.address, got, tt.valid)
+			}
+		})
+	}
+}
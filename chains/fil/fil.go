@@ -0,0 +1,123 @@
+package fil
+
+import (
+	"math/big"
+
+	"github.com/airsigner/libcrypto/types"
+	"github.com/airsigner/libcrypto/types/pb"
+	"github.com/shopspring/decimal"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+type filDefinition struct{}
+
+func (filDefinition) CoinName() string { return "FIL" }
+func (filDefinition) UnitExp() int32   { return 18 }
+
+// Denominations returns attoFIL, nanoFIL and FIL, see types.CoinValue.Humanize.
+func (filDefinition) Denominations() []types.Denomination {
+	return []types.Denomination{
+		{Name: "attoFIL", Exp: 0},
+		{Name: "nanoFIL", Exp: 9},
+		{Name: "FIL", Exp: 18},
+	}
+}
+
+// Denominations returns the denominations of FIL, from the smallest to the largest, see types.CoinValue.In.
+func Denominations() []types.Denomination {
+	return filDefinition{}.Denominations()
+}
+
+func init() {
+	types.Register(filDefinition{}.CoinName(), func(atto *big.Int) types.Value {
+		return NewFilFromAtto(atto)
+	})
+}
+
+var _ types.Value = (*Fil)(nil)
+
+type Fil struct {
+	*types.CoinValue[filDefinition]
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, see types.CoinValue.UnmarshalBinary.
+func (f *Fil) UnmarshalBinary(data []byte) error {
+	return types.DecodeInto(&f.CoinValue, func(v *types.CoinValue[filDefinition]) error {
+		return v.UnmarshalBinary(data)
+	})
+}
+
+// FromProto sets the value to the value of its protobuf representation, see types.CoinValue.FromProto.
+func (f *Fil) FromProto(m *pb.CoinValue) error {
+	return types.DecodeInto(&f.CoinValue, func(v *types.CoinValue[filDefinition]) error {
+		return v.FromProto(m)
+	})
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, see types.CoinValue.UnmarshalCBOR.
+func (f *Fil) UnmarshalCBOR(data []byte) error {
+	return types.DecodeInto(&f.CoinValue, func(v *types.CoinValue[filDefinition]) error {
+		return v.UnmarshalCBOR(data)
+	})
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler, see types.CoinValue.UnmarshalBSONValue.
+func (f *Fil) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	return types.DecodeInto(&f.CoinValue, func(v *types.CoinValue[filDefinition]) error {
+		return v.UnmarshalBSONValue(t, data)
+	})
+}
+
+func NewFil(fil decimal.Decimal) *Fil {
+	return &Fil{
+		types.NewCoinValueFromCoins[filDefinition](fil),
+	}
+}
+
+// NewFilExact is like NewFil but fails instead of truncating an amount more precise than the smallest unit.
+func NewFilExact(fil decimal.Decimal) (*Fil, error) {
+	cv, err := types.NewCoinValueFromCoinsChecked[filDefinition](fil)
+	if err != nil {
+		return nil, err
+	}
+	return &Fil{cv}, nil
+}
+
+// NewFilFromString parses a decimal string amount of FIL, e.g. "1.5".
+func NewFilFromString(s string) (*Fil, error) {
+	cv, err := types.ParseCoinValue[filDefinition](s)
+	if err != nil {
+		return nil, err
+	}
+	return &Fil{cv}, nil
+}
+
+// MustNewFil is like NewFilFromString but panics if s can't be parsed, intended for tests and constants.
+func MustNewFil(s string) *Fil {
+	return &Fil{types.MustParseCoinValue[filDefinition](s)}
+}
+
+// NewFilFromValue creates a Fil from a Value of the same coin, e.g. the result of Fil.Add.
+func NewFilFromValue(v types.Value) (*Fil, error) {
+	cv, err := types.NewCoinValueFromValue[filDefinition](v)
+	if err != nil {
+		return nil, err
+	}
+	return &Fil{cv}, nil
+}
+
+func NewFilFromAtto(atto *big.Int) *Fil {
+	return &Fil{
+		types.NewCoinValue[filDefinition](atto),
+	}
+}
+
+// Atto returns the value of the Fil type in attoFIL.
+func (f Fil) Atto() *big.Int {
+	return f.Units()
+}
+
+// Fil returns the value of the Fil type in FIL.
+func (f Fil) Fil() decimal.Decimal {
+	return f.Coins()
+}
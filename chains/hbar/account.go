@@ -0,0 +1,103 @@
+package hbar
+
+import (
+	"strconv"
+	"strings"
+)
+
+// LedgerID identifies a Hedera network, it is part of the account ID checksum.
+type LedgerID []byte
+
+var (
+	Mainnet    = LedgerID{0x00}
+	Testnet    = LedgerID{0x01}
+	Previewnet = LedgerID{0x02}
+)
+
+const checksumLen = 5
+
+// IsValidAccountID checks if the id is a valid Hedera account ID in the shard.realm.num form, e.g. "0.0.1234".
+//
+// The id may carry a checksum suffix, e.g. "0.0.1234-abcde", which is only checked for its form;
+// use IsValidAccountIDChecksum to verify it against a network.
+func IsValidAccountID(id string) bool {
+	_, ok := splitAccountID(id)
+	return ok
+}
+
+// IsValidAccountIDChecksum checks if the id is a valid Hedera account ID with a checksum suffix
+// matching the given network, as defined in HIP-15, e.g. "0.0.123-vfmkw" on mainnet.
+func IsValidAccountIDChecksum(id string, ledger LedgerID) bool {
+	num, ok := splitAccountID(id)
+	if !ok || len(id) == len(num) {
+		return false
+	}
+	return id[len(num)+1:] == checksum(num, ledger)
+}
+
+// splitAccountID validates the id and returns its shard.realm.num part, without the checksum.
+func splitAccountID(id string) (string, bool) {
+	num, sum, hasSum := strings.Cut(id, "-")
+	if hasSum && (len(sum) != checksumLen || strings.Trim(sum, "abcdefghijklmnopqrstuvwxyz") != "") {
+		return "", false
+	}
+
+	parts := strings.Split(num, ".")
+	if len(parts) != 3 {
+		return "", false
+	}
+	for _, part := range parts {
+		if !isComponent(part) {
+			return "", false
+		}
+	}
+	return num, true
+}
+
+// isComponent checks if s is a non-negative 64 bits integer, without sign nor leading zeros.
+func isComponent(s string) bool {
+	if s == "" || s[0] == '+' || len(s) > 1 && s[0] == '0' {
+		return false
+	}
+	_, err := strconv.ParseInt(s, 10, 64)
+	return err == nil
+}
+
+// checksum computes the HIP-15 checksum of the shard.realm.num address on the given network.
+func checksum(address string, ledger LedgerID) string {
+	const (
+		p3 = 26 * 26 * 26
+		p5 = 26 * 26 * 26 * 26 * 26
+		m  = 1_000_003
+		w  = 31
+	)
+
+	var sd0, sd1, sd, sh int
+	for i := 0; i < len(address); i++ {
+		d := 10 // '.'
+		if address[i] != '.' {
+			d = int(address[i] - '0')
+		}
+		sd = (w*sd + d) % p3
+		if i%2 == 0 {
+			sd0 = (sd0 + d) % 11
+		} else {
+			sd1 = (sd1 + d) % 11
+		}
+	}
+
+	h := append(append([]byte{}, ledger...), make([]byte, 6)...)
+	for _, b := range h {
+		sh = (w*sh + int(b)) % p5
+	}
+
+	c := ((((len(address)%5)*11+sd0)*11+sd1)*p3 + sd + sh) % p5
+	cp := (c * m) % p5
+
+	answer := make([]byte, checksumLen)
+	for i := checksumLen - 1; i >= 0; i-- {
+		answer[i] = byte('a' + cp%26)
+		cp /= 26
+	}
+	return string(answer)
+}
@@ -0,0 +1,58 @@
+package hbar
+
+import "testing"
+
+func TestIsValidAccountID(t *testing.T) {
+	tests := []struct {
+		id    string
+		valid bool
+	}{
+		{"0.0.3", true},
+		{"0.0.1234", true},
+		{"1.2.3", true},
+		{"0.0.9223372036854775807", true},
+		{"0.0.123-vfmkw", true},
+		{"0.0.123-zzzzz", true}, // the checksum is only checked for its form
+
+		{"0.0", false},
+		{"0.0.0.3", false},
+		{"0..3", false},
+		{"0.0.-3", false},
+		{"0.0.+3", false},
+		{"0.0.03", false},
+		{"0.0.9223372036854775808", false},
+		{"0.0.abc", false},
+		{"0.0.123-vfmk", false},
+		{"0.0.123-VFMKW", false},
+		{"0.0.123-", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		if got := IsValidAccountID(tt.id); got != tt.valid {
+			t.Errorf("IsValidAccountID(%q) = %v, want %v", tt.id, got, tt.valid)
+		}
+	}
+}
+
+func TestIsValidAccountIDChecksum(t *testing.T) {
+	tests := []struct {
+		id     string
+		ledger LedgerID
+		valid  bool
+	}{
+		// HIP-15 examples
+		{"0.0.123-vfmkw", Mainnet, true},
+		{"0.0.123-esxsf", Testnet, true},
+
+		{"0.0.123-vfmkw", Testnet, false},
+		{"0.0.123-esxsf", Mainnet, false},
+		{"0.0.124-vfmkw", Mainnet, false},
+		{"0.0.123", Mainnet, false},
+		{"0.0-vfmkw", Mainnet, false},
+	}
+	for _, tt := range tests {
+		if got := IsValidAccountIDChecksum(tt.id, tt.ledger); got != tt.valid {
+			t.Errorf("IsValidAccountIDChecksum(%q, %x) = %v, want %v", tt.id, tt.ledger, got, tt.valid)
+		}
+	}
+}
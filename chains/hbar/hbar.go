@@ -0,0 +1,122 @@
+package hbar
+
+import (
+	"math/big"
+
+	"github.com/airsigner/libcrypto/types"
+	"github.com/airsigner/libcrypto/types/pb"
+	"github.com/shopspring/decimal"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+type hbarDefinition struct{}
+
+func (hbarDefinition) CoinName() string { return "HBAR" }
+func (hbarDefinition) UnitExp() int32   { return 8 }
+
+// Denominations returns tinybar and HBAR, see types.CoinValue.Humanize.
+func (hbarDefinition) Denominations() []types.Denomination {
+	return []types.Denomination{
+		{Name: "tinybar", Exp: 0},
+		{Name: "HBAR", Exp: 8},
+	}
+}
+
+// Denominations returns the denominations of HBAR, from the smallest to the largest, see types.CoinValue.In.
+func Denominations() []types.Denomination {
+	return hbarDefinition{}.Denominations()
+}
+
+func init() {
+	types.Register(hbarDefinition{}.CoinName(), func(tinybar *big.Int) types.Value {
+		return NewHbarFromTinybar(tinybar)
+	})
+}
+
+var _ types.Value = (*Hbar)(nil)
+
+type Hbar struct {
+	*types.CoinValue[hbarDefinition]
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, see types.CoinValue.UnmarshalBinary.
+func (h *Hbar) UnmarshalBinary(data []byte) error {
+	return types.DecodeInto(&h.CoinValue, func(v *types.CoinValue[hbarDefinition]) error {
+		return v.UnmarshalBinary(data)
+	})
+}
+
+// FromProto sets the value to the value of its protobuf representation, see types.CoinValue.FromProto.
+func (h *Hbar) FromProto(m *pb.CoinValue) error {
+	return types.DecodeInto(&h.CoinValue, func(v *types.CoinValue[hbarDefinition]) error {
+		return v.FromProto(m)
+	})
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, see types.CoinValue.UnmarshalCBOR.
+func (h *Hbar) UnmarshalCBOR(data []byte) error {
+	return types.DecodeInto(&h.CoinValue, func(v *types.CoinValue[hbarDefinition]) error {
+		return v.UnmarshalCBOR(data)
+	})
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler, see types.CoinValue.UnmarshalBSONValue.
+func (h *Hbar) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	return types.DecodeInto(&h.CoinValue, func(v *types.CoinValue[hbarDefinition]) error {
+		return v.UnmarshalBSONValue(t, data)
+	})
+}
+
+func NewHbar(hbar decimal.Decimal) *Hbar {
+	return &Hbar{
+		types.NewCoinValueFromCoins[hbarDefinition](hbar),
+	}
+}
+
+// NewHbarExact is like NewHbar but fails instead of truncating an amount more precise than the smallest unit.
+func NewHbarExact(hbar decimal.Decimal) (*Hbar, error) {
+	cv, err := types.NewCoinValueFromCoinsChecked[hbarDefinition](hbar)
+	if err != nil {
+		return nil, err
+	}
+	return &Hbar{cv}, nil
+}
+
+// NewHbarFromString parses a decimal string amount of HBAR, e.g. "1.5".
+func NewHbarFromString(s string) (*Hbar, error) {
+	cv, err := types.ParseCoinValue[hbarDefinition](s)
+	if err != nil {
+		return nil, err
+	}
+	return &Hbar{cv}, nil
+}
+
+// MustNewHbar is like NewHbarFromString but panics if s can't be parsed, intended for tests and constants.
+func MustNewHbar(s string) *Hbar {
+	return &Hbar{types.MustParseCoinValue[hbarDefinition](s)}
+}
+
+// NewHbarFromValue creates a Hbar from a Value of the same coin, e.g. the result of Hbar.Add.
+func NewHbarFromValue(v types.Value) (*Hbar, error) {
+	cv, err := types.NewCoinValueFromValue[hbarDefinition](v)
+	if err != nil {
+		return nil, err
+	}
+	return &Hbar{cv}, nil
+}
+
+func NewHbarFromTinybar(tinybar *big.Int) *Hbar {
+	return &Hbar{
+		types.NewCoinValue[hbarDefinition](tinybar),
+	}
+}
+
+// Tinybar returns the value of the Hbar type in tinybars.
+func (h Hbar) Tinybar() *big.Int {
+	return h.Units()
+}
+
+// Hbar returns the value of the Hbar type in hbars.
+func (h Hbar) Hbar() decimal.Decimal {
+	return h.Coins()
+}
@@ -0,0 +1,35 @@
+// Package move provides the address handling shared by Move chains such as Aptos and Sui,
+// whose addresses are 32 bytes hex strings, often written without their leading zeros.
+package move
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+var (
+	// ErrInvalidAddress is returned when an address isn't a valid Move address.
+	ErrInvalidAddress = errors.New("invalid address")
+
+	addrRegex = regexp.MustCompile("^0x[0-9a-fA-F]{1,64}$")
+)
+
+// IsValidAddress checks if the address is a 0x prefixed hex address of 1 to 64 hex characters.
+func IsValidAddress(address string) bool {
+	return addrRegex.MatchString(address)
+}
+
+// NormalizeAddress returns the long form of the address, i.e. lowercase and zero padded to 64 hex characters,
+// e.g. "0x1" becomes "0x0000000000000000000000000000000000000000000000000000000000000001".
+//
+// Returns:
+// - string: the normalized address.
+// - error: ErrInvalidAddress if the address is invalid.
+func NormalizeAddress(address string) (string, error) {
+	if !IsValidAddress(address) {
+		return "", ErrInvalidAddress
+	}
+	hex := strings.ToLower(address[2:])
+	return "0x" + strings.Repeat("0", 64-len(hex)) + hex, nil
+}
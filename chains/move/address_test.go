@@ -0,0 +1,32 @@
+package move
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestNormalizeAddress(t *testing.T) {
+	full := "0x" + strings.Repeat("0", 63) + "1"
+	tests := []struct {
+		address string
+		want    string
+	}{
+		{"0x1", full},
+		{full, full},
+		{"0xA550C18", "0x" + strings.Repeat("0", 57) + "a550c18"},
+		{"0x" + strings.Repeat("F", 64), "0x" + strings.Repeat("f", 64)},
+	}
+	for _, tt := range tests {
+		got, err := NormalizeAddress(tt.address)
+		if err != nil || got != tt.want {
+			t.Errorf("NormalizeAddress(%s) = %s, %v, want %s", tt.address, got, err, tt.want)
+		}
+	}
+
+	for _, address := range []string{"", "0x", "1", "0xg", "0x" + strings.Repeat("1", 65)} {
+		if got, err := NormalizeAddress(address); !errors.Is(err, ErrInvalidAddress) {
+			t.Errorf("NormalizeAddress(%q) = %s, %v, want ErrInvalidAddress", address, got, err)
+		}
+	}
+}
@@ -0,0 +1,8 @@
+package polygon
+
+// IsValidAddress checks if the address is a valid Polygon address.
+//
+// Polygon addresses have the same format as all EVM addresses.
+func IsValidAddress(address string) bool {
+	return Chain.IsValidAddress(address)
+}
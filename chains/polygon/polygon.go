@@ -0,0 +1,112 @@
+package polygon
+
+import (
+	"math/big"
+
+	"github.com/airsigner/libcrypto/chains/evm"
+	"github.com/airsigner/libcrypto/types"
+	"github.com/shopspring/decimal"
+)
+
+// Chain is the Polygon PoS chain.
+var Chain = evm.NewChain("MATIC", 18, 137)
+
+type maticDefinition struct{}
+
+func (maticDefinition) CoinName() string { return Chain.CoinName() }
+func (maticDefinition) UnitExp() int32   { return Chain.UnitExp() }
+
+// Denominations returns wei, gwei and MATIC, see types.CoinValue.Humanize.
+func (maticDefinition) Denominations() []types.Denomination {
+	return Chain.Denominations()
+}
+
+// Denominations returns the denominations of MATIC, from the smallest to the largest, see types.CoinValue.In.
+func Denominations() []types.Denomination {
+	return maticDefinition{}.Denominations()
+}
+
+func init() {
+	types.Register(maticDefinition{}.CoinName(), func(wei *big.Int) types.Value {
+		return NewMaticFromWei(wei)
+	})
+}
+
+var _ types.Value = (*Matic)(nil)
+
+// Matic is an amount of MATIC, the native coin of Polygon PoS, with the wei helpers of evm.Coin.
+type Matic struct {
+	evm.Coin[maticDefinition]
+}
+
+func newMatic(cv *types.CoinValue[maticDefinition]) *Matic {
+	return &Matic{evm.Coin[maticDefinition]{CoinValue: cv}}
+}
+
+// newMaticExact wraps the result of an exact constructor of types.CoinValue.
+func newMaticExact(cv *types.CoinValue[maticDefinition], err error) (*Matic, error) {
+	if err != nil {
+		return nil, err
+	}
+	return newMatic(cv), nil
+}
+
+func NewMatic(matic decimal.Decimal) *Matic {
+	return newMatic(types.NewCoinValueFromCoins[maticDefinition](matic))
+}
+
+// NewMaticExact is like NewMatic but fails instead of truncating an amount more precise than the smallest unit.
+func NewMaticExact(matic decimal.Decimal) (*Matic, error) {
+	return newMaticExact(types.NewCoinValueFromCoinsChecked[maticDefinition](matic))
+}
+
+// NewMaticFromString parses a decimal string amount of MATIC, e.g. "1.5".
+func NewMaticFromString(s string) (*Matic, error) {
+	return newMaticExact(types.ParseCoinValue[maticDefinition](s))
+}
+
+// MustNewMatic is like NewMaticFromString but panics if s can't be parsed, intended for tests and constants.
+func MustNewMatic(s string) *Matic {
+	return newMatic(types.MustParseCoinValue[maticDefinition](s))
+}
+
+// NewMaticFromValue creates a Matic from a Value of the same coin, e.g. the result of Matic.Add.
+func NewMaticFromValue(v types.Value) (*Matic, error) {
+	return newMaticExact(types.NewCoinValueFromValue[maticDefinition](v))
+}
+
+func NewMaticFromWei(wei *big.Int) *Matic {
+	return newMatic(types.NewCoinValue[maticDefinition](wei))
+}
+
+func NewMaticFromKWei(kwei decimal.Decimal) *Matic {
+	return newMatic(types.NewCoinValueFromScaled[maticDefinition](kwei, evm.KWeiExp))
+}
+
+// NewMaticFromKWeiExact is like NewMaticFromKWei but fails instead of truncating an amount more precise than the wei.
+func NewMaticFromKWeiExact(kwei decimal.Decimal) (*Matic, error) {
+	return newMaticExact(types.NewCoinValueFromScaledChecked[maticDefinition](kwei, evm.KWeiExp))
+}
+
+func NewMaticFromMWei(mwei decimal.Decimal) *Matic {
+	return newMatic(types.NewCoinValueFromScaled[maticDefinition](mwei, evm.MWeiExp))
+}
+
+// NewMaticFromMWeiExact is like NewMaticFromMWei but fails instead of truncating an amount more precise than the wei.
+func NewMaticFromMWeiExact(mwei decimal.Decimal) (*Matic, error) {
+	return newMaticExact(types.NewCoinValueFromScaledChecked[maticDefinition](mwei, evm.MWeiExp))
+}
+
+func NewMaticFromGWei(gwei decimal.Decimal) *Matic {
+	return newMatic(types.NewCoinValueFromScaled[maticDefinition](gwei, evm.GWeiExp))
+}
+
+// NewMaticFromGWeiExact is like NewMaticFromGWei but fails instead of truncating an amount more precise than the wei.
+func NewMaticFromGWeiExact(gwei decimal.Decimal) (*Matic, error) {
+	return newMaticExact(types.NewCoinValueFromScaledChecked[maticDefinition](gwei, evm.GWeiExp))
+}
+
+// Matic returns the value of the Matic type in MATIC.
+func (m Matic) Matic() decimal.Decimal {
+	return m.Coins()
+}
@@ -0,0 +1,82 @@
+package polygon
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/airsigner/libcrypto/chains/eth"
+	"github.com/airsigner/libcrypto/types/valuetest"
+	"github.com/shopspring/decimal"
+)
+
+func TestMaticValue(t *testing.T) {
+	valuetest.AssertValue(t, NewMaticFromWei)
+}
+
+func TestMaticScaling(t *testing.T) {
+	a := MustNewMatic("1.5")
+	if want := big.NewInt(1_500_000_000_000_000_000); a.Wei().Cmp(want) != 0 {
+		t.Errorf("Wei() = %s, want %s", a.Wei(), want)
+	}
+	for name, got := range map[string]decimal.Decimal{
+		"KWei":  a.KWei(),
+		"MWei":  a.MWei(),
+		"GWei":  a.GWei(),
+		"Matic": a.Matic(),
+	} {
+		want := map[string]string{"KWei": "1500000000000000", "MWei": "1500000000000", "GWei": "1500000000", "Matic": "1.5"}[name]
+		if got.String() != want {
+			t.Errorf("%s() = %s, want %s", name, got, want)
+		}
+	}
+
+	ctors := map[string]*Matic{
+		"NewMatic":         NewMatic(decimal.RequireFromString("1.5")),
+		"NewMaticFromKWei": NewMaticFromKWei(decimal.RequireFromString("1500000000000000")),
+		"NewMaticFromMWei": NewMaticFromMWei(decimal.RequireFromString("1500000000000")),
+		"NewMaticFromGWei": NewMaticFromGWei(decimal.RequireFromString("1500000000")),
+	}
+	for name, got := range ctors {
+		if !got.Equals(a) {
+			t.Errorf("%s() = %s wei, want %s", name, got.Wei(), a.Wei())
+		}
+	}
+
+	if _, err := NewMaticFromGWeiExact(decimal.RequireFromString("0.0000000001")); err == nil {
+		t.Errorf("NewMaticFromGWeiExact() of a tenth of a wei succeeded, want an error")
+	}
+	if _, err := NewMaticFromValue(eth.MustNewEth("1")); err == nil {
+		t.Errorf("NewMaticFromValue() of ETH succeeded, want an error")
+	}
+}
+
+func TestIsValidAddressParity(t *testing.T) {
+	for _, address := range []string{
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		"0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed",
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAe",
+		"5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed00",
+		"",
+	} {
+		if got, want := IsValidAddress(address), eth.IsValidAddress(address); got != want {
+			t.Errorf("IsValidAddress(%q) = %v, want %v like eth.IsValidAddress", address, got, want)
+		}
+	}
+}
+
+func TestMaticBinaryRoundTrip(t *testing.T) {
+	want := MustNewMatic("1.5")
+	data, err := want.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got Matic
+	if err := got.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary() into a zero Matic: %v", err)
+	}
+	if !want.Equals(got) || got.GWei().String() != "1500000000" {
+		t.Errorf("UnmarshalBinary() = %s, want %s", got, want)
+	}
+}
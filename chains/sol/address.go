@@ -0,0 +1,13 @@
+package sol
+
+import "github.com/airsigner/libcrypto/internal/base58"
+
+const addressLen = 32
+
+// IsValidAddress checks if the address is a valid Solana address, the base58 encoding of 32 bytes.
+//
+// Both ed25519 public keys and program derived addresses, which are deliberately off the curve, are valid.
+func IsValidAddress(address string) bool {
+	b, err := base58.Decode(address)
+	return err == nil && len(b) == addressLen
+}
@@ -0,0 +1,31 @@
+package sol
+
+import "testing"
+
+func TestIsValidAddress(t *testing.T) {
+	tests := []struct {
+		name    string
+		address string
+		valid   bool
+	}{
+		{"system program", "11111111111111111111111111111111", true},
+		{"token program", "TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA", true},
+		{"wrapped SOL mint", "So11111111111111111111111111111111111111112", true},
+		{"sysvar", "Sysvar1nstructions1111111111111111111111111", true},
+		{"all ones", "JEKNVnkbo3jma5nREBBJCDoXFVeKkD56V3xKrvRmWxFG", true},
+
+		{"31 bytes", "4uQeVj5tqViQh7yWWGStvkEG1Zmhx6uasJtWCJziofL", false},
+		{"31 zero bytes", "1111111111111111111111111111111", false},
+		{"33 bytes", "JJEfe6DcPM2ziB2vfUWDV6aHVerXRGkv3TcyvJUNGHZz", false},
+		{"not base58", "TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5D0", false},
+		{"ethereum address", "0x52908400098527886E0F7030069857D2E4169EE7", false},
+		{"empty", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsValidAddress(tt.address); got != tt.valid {
+				t.Errorf("IsValidAddress(%q) = %v, want %v", tt.address, got, tt.valid)
+			}
+		})
+	}
+}
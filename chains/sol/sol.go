@@ -0,0 +1,122 @@
+package sol
+
+import (
+	"math/big"
+
+	"github.com/airsigner/libcrypto/types"
+	"github.com/airsigner/libcrypto/types/pb"
+	"github.com/shopspring/decimal"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+type solDefinition struct{}
+
+func (solDefinition) CoinName() string { return "SOL" }
+func (solDefinition) UnitExp() int32   { return 9 }
+
+// Denominations returns lamport and SOL, see types.CoinValue.Humanize.
+func (solDefinition) Denominations() []types.Denomination {
+	return []types.Denomination{
+		{Name: "lamport", Exp: 0},
+		{Name: "SOL", Exp: 9},
+	}
+}
+
+// Denominations returns the denominations of SOL, from the smallest to the largest, see types.CoinValue.In.
+func Denominations() []types.Denomination {
+	return solDefinition{}.Denominations()
+}
+
+func init() {
+	types.Register(solDefinition{}.CoinName(), func(lamports *big.Int) types.Value {
+		return NewSolFromLamports(lamports)
+	})
+}
+
+var _ types.Value = (*Sol)(nil)
+
+type Sol struct {
+	*types.CoinValue[solDefinition]
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, see types.CoinValue.UnmarshalBinary.
+func (s *Sol) UnmarshalBinary(data []byte) error {
+	return types.DecodeInto(&s.CoinValue, func(v *types.CoinValue[solDefinition]) error {
+		return v.UnmarshalBinary(data)
+	})
+}
+
+// FromProto sets the value to the value of its protobuf representation, see types.CoinValue.FromProto.
+func (s *Sol) FromProto(m *pb.CoinValue) error {
+	return types.DecodeInto(&s.CoinValue, func(v *types.CoinValue[solDefinition]) error {
+		return v.FromProto(m)
+	})
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, see types.CoinValue.UnmarshalCBOR.
+func (s *Sol) UnmarshalCBOR(data []byte) error {
+	return types.DecodeInto(&s.CoinValue, func(v *types.CoinValue[solDefinition]) error {
+		return v.UnmarshalCBOR(data)
+	})
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler, see types.CoinValue.UnmarshalBSONValue.
+func (s *Sol) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	return types.DecodeInto(&s.CoinValue, func(v *types.CoinValue[solDefinition]) error {
+		return v.UnmarshalBSONValue(t, data)
+	})
+}
+
+func NewSol(sol decimal.Decimal) *Sol {
+	return &Sol{
+		types.NewCoinValueFromCoins[solDefinition](sol),
+	}
+}
+
+// NewSolExact is like NewSol but fails instead of truncating an amount more precise than the smallest unit.
+func NewSolExact(sol decimal.Decimal) (*Sol, error) {
+	cv, err := types.NewCoinValueFromCoinsChecked[solDefinition](sol)
+	if err != nil {
+		return nil, err
+	}
+	return &Sol{cv}, nil
+}
+
+// NewSolFromString parses a decimal string amount of SOL, e.g. "1.5".
+func NewSolFromString(s string) (*Sol, error) {
+	cv, err := types.ParseCoinValue[solDefinition](s)
+	if err != nil {
+		return nil, err
+	}
+	return &Sol{cv}, nil
+}
+
+// MustNewSol is like NewSolFromString but panics if s can't be parsed, intended for tests and constants.
+func MustNewSol(s string) *Sol {
+	return &Sol{types.MustParseCoinValue[solDefinition](s)}
+}
+
+// NewSolFromValue creates a Sol from a Value of the same coin, e.g. the result of Sol.Add.
+func NewSolFromValue(v types.Value) (*Sol, error) {
+	cv, err := types.NewCoinValueFromValue[solDefinition](v)
+	if err != nil {
+		return nil, err
+	}
+	return &Sol{cv}, nil
+}
+
+func NewSolFromLamports(lamports *big.Int) *Sol {
+	return &Sol{
+		types.NewCoinValue[solDefinition](lamports),
+	}
+}
+
+// Lamports returns the value of the Sol type in lamports.
+func (s Sol) Lamports() *big.Int {
+	return s.Units()
+}
+
+// Sol returns the value of the Sol type in SOL.
+func (s Sol) Sol() decimal.Decimal {
+	return s.Coins()
+}
@@ -0,0 +1,16 @@
+package sui
+
+import "github.com/airsigner/libcrypto/chains/move"
+
+// ErrInvalidAddress is returned when an address isn't a valid Sui address.
+var ErrInvalidAddress = move.ErrInvalidAddress
+
+// IsValidAddress checks if the address is a valid Sui address, see move.IsValidAddress.
+func IsValidAddress(address string) bool {
+	return move.IsValidAddress(address)
+}
+
+// NormalizeAddress returns the long zero padded form of the Sui address, see move.NormalizeAddress.
+func NormalizeAddress(address string) (string, error) {
+	return move.NormalizeAddress(address)
+}
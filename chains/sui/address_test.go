@@ -0,0 +1,17 @@
+package sui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestNormalizeAddress(t *testing.T) {
+	want := "0x" + strings.Repeat("0", 63) + "1"
+	if got, err := NormalizeAddress("0x1"); err != nil || got != want {
+		t.Errorf("NormalizeAddress(0x1) = %s, %v, want %s", got, err, want)
+	}
+	if got, err := NormalizeAddress("0x1g"); !errors.Is(err, ErrInvalidAddress) {
+		t.Errorf("NormalizeAddress(0x1g) = %s, %v, want ErrInvalidAddress", got, err)
+	}
+}
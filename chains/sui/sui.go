@@ -0,0 +1,122 @@
+package sui
+
+import (
+	"math/big"
+
+	"github.com/airsigner/libcrypto/types"
+	"github.com/airsigner/libcrypto/types/pb"
+	"github.com/shopspring/decimal"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+type suiDefinition struct{}
+
+func (suiDefinition) CoinName() string { return "SUI" }
+func (suiDefinition) UnitExp() int32   { return 9 }
+
+// Denominations returns mist and SUI, see types.CoinValue.Humanize.
+func (suiDefinition) Denominations() []types.Denomination {
+	return []types.Denomination{
+		{Name: "mist", Exp: 0},
+		{Name: "SUI", Exp: 9},
+	}
+}
+
+// Denominations returns the denominations of SUI, from the smallest to the largest, see types.CoinValue.In.
+func Denominations() []types.Denomination {
+	return suiDefinition{}.Denominations()
+}
+
+func init() {
+	types.Register(suiDefinition{}.CoinName(), func(mist *big.Int) types.Value {
+		return NewSuiFromMist(mist)
+	})
+}
+
+var _ types.Value = (*Sui)(nil)
+
+type Sui struct {
+	*types.CoinValue[suiDefinition]
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, see types.CoinValue.UnmarshalBinary.
+func (s *Sui) UnmarshalBinary(data []byte) error {
+	return types.DecodeInto(&s.CoinValue, func(v *types.CoinValue[suiDefinition]) error {
+		return v.UnmarshalBinary(data)
+	})
+}
+
+// FromProto sets the value to the value of its protobuf representation, see types.CoinValue.FromProto.
+func (s *Sui) FromProto(m *pb.CoinValue) error {
+	return types.DecodeInto(&s.CoinValue, func(v *types.CoinValue[suiDefinition]) error {
+		return v.FromProto(m)
+	})
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, see types.CoinValue.UnmarshalCBOR.
+func (s *Sui) UnmarshalCBOR(data []byte) error {
+	return types.DecodeInto(&s.CoinValue, func(v *types.CoinValue[suiDefinition]) error {
+		return v.UnmarshalCBOR(data)
+	})
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler, see types.CoinValue.UnmarshalBSONValue.
+func (s *Sui) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	return types.DecodeInto(&s.CoinValue, func(v *types.CoinValue[suiDefinition]) error {
+		return v.UnmarshalBSONValue(t, data)
+	})
+}
+
+func NewSui(sui decimal.Decimal) *Sui {
+	return &Sui{
+		types.NewCoinValueFromCoins[suiDefinition](sui),
+	}
+}
+
+// NewSuiExact is like NewSui but fails instead of truncating an amount more precise than the smallest unit.
+func NewSuiExact(sui decimal.Decimal) (*Sui, error) {
+	cv, err := types.NewCoinValueFromCoinsChecked[suiDefinition](sui)
+	if err != nil {
+		return nil, err
+	}
+	return &Sui{cv}, nil
+}
+
+// NewSuiFromString parses a decimal string amount of SUI, e.g. "1.5".
+func NewSuiFromString(s string) (*Sui, error) {
+	cv, err := types.ParseCoinValue[suiDefinition](s)
+	if err != nil {
+		return nil, err
+	}
+	return &Sui{cv}, nil
+}
+
+// MustNewSui is like NewSuiFromString but panics if s can't be parsed, intended for tests and constants.
+func MustNewSui(s string) *Sui {
+	return &Sui{types.MustParseCoinValue[suiDefinition](s)}
+}
+
+// NewSuiFromValue creates a Sui from a Value of the same coin, e.g. the result of Sui.Add.
+func NewSuiFromValue(v types.Value) (*Sui, error) {
+	cv, err := types.NewCoinValueFromValue[suiDefinition](v)
+	if err != nil {
+		return nil, err
+	}
+	return &Sui{cv}, nil
+}
+
+func NewSuiFromMist(mist *big.Int) *Sui {
+	return &Sui{
+		types.NewCoinValue[suiDefinition](mist),
+	}
+}
+
+// Mist returns the value of the Sui type in MIST.
+func (s Sui) Mist() *big.Int {
+	return s.Units()
+}
+
+// Sui returns the value of the Sui type in SUI.
+func (s Sui) Sui() decimal.Decimal {
+	return s.Coins()
+}
@@ -0,0 +1,71 @@
+package xlm
+
+import (
+	"encoding/base32"
+	"encoding/binary"
+)
+
+const (
+	// strkey version bytes, the first character of the address is derived from them
+	versionAccount = 6 << 3  // G...
+	versionMuxed   = 12 << 3 // M...
+
+	accountPayloadLen = 32     // ed25519 public key
+	muxedPayloadLen   = 32 + 8 // ed25519 public key and 64 bits id
+)
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// IsValidAddress checks if the address is a valid Stellar account (G...) or muxed account (M...) address.
+func IsValidAddress(address string) bool {
+	_, ok := decodeStrKey(address)
+	return ok
+}
+
+// IsMuxedAddress checks if the address is a valid Stellar muxed account (M...) address.
+func IsMuxedAddress(address string) bool {
+	version, ok := decodeStrKey(address)
+	return ok && version == versionMuxed
+}
+
+// decodeStrKey decodes an account strkey, verifying its checksum and payload length, and returns its version byte.
+func decodeStrKey(address string) (byte, bool) {
+	b, err := encoding.DecodeString(address)
+	if err != nil || len(b) < 3 {
+		return 0, false
+	}
+
+	data, checksum := b[:len(b)-2], b[len(b)-2:]
+	if binary.LittleEndian.Uint16(checksum) != crc16(data) {
+		return 0, false
+	}
+	// reject non-canonical encodings, whose unused trailing bits aren't zero
+	if encoding.EncodeToString(b) != address {
+		return 0, false
+	}
+
+	version, payload := data[0], data[1:]
+	switch version {
+	case versionAccount:
+		return version, len(payload) == accountPayloadLen
+	case versionMuxed:
+		return version, len(payload) == muxedPayloadLen
+	}
+	return 0, false
+}
+
+// crc16 computes the CRC16-XModem checksum of data.
+func crc16(data []byte) uint16 {
+	var crc uint16
+	for _, b := range data {
+		crc ^= uint16(b) << 8
+		for i := 0; i < 8; i++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
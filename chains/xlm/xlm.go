@@ -0,0 +1,122 @@
+package xlm
+
+import (
+	"math/big"
+
+	"github.com/airsigner/libcrypto/types"
+	"github.com/airsigner/libcrypto/types/pb"
+	"github.com/shopspring/decimal"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+type xlmDefinition struct{}
+
+func (xlmDefinition) CoinName() string { return "XLM" }
+func (xlmDefinition) UnitExp() int32   { return 7 }
+
+// Denominations returns stroop and XLM, see types.CoinValue.Humanize.
+func (xlmDefinition) Denominations() []types.Denomination {
+	return []types.Denomination{
+		{Name: "stroop", Exp: 0},
+		{Name: "XLM", Exp: 7},
+	}
+}
+
+// Denominations returns the denominations of XLM, from the smallest to the largest, see types.CoinValue.In.
+func Denominations() []types.Denomination {
+	return xlmDefinition{}.Denominations()
+}
+
+func init() {
+	types.Register(xlmDefinition{}.CoinName(), func(stroops *big.Int) types.Value {
+		return NewXlmFromStroops(stroops)
+	})
+}
+
+var _ types.Value = (*Xlm)(nil)
+
+type Xlm struct {
+	*types.CoinValue[xlmDefinition]
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, see types.CoinValue.UnmarshalBinary.
+func (x *Xlm) UnmarshalBinary(data []byte) error {
+	return types.DecodeInto(&x.CoinValue, func(v *types.CoinValue[xlmDefinition]) error {
+		return v.UnmarshalBinary(data)
+	})
+}
+
+// FromProto sets the value to the value of its protobuf representation, see types.CoinValue.FromProto.
+func (x *Xlm) FromProto(m *pb.CoinValue) error {
+	return types.DecodeInto(&x.CoinValue, func(v *types.CoinValue[xlmDefinition]) error {
+		return v.FromProto(m)
+	})
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, see types.CoinValue.UnmarshalCBOR.
+func (x *Xlm) UnmarshalCBOR(data []byte) error {
+	return types.DecodeInto(&x.CoinValue, func(v *types.CoinValue[xlmDefinition]) error {
+		return v.UnmarshalCBOR(data)
+	})
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler, see types.CoinValue.UnmarshalBSONValue.
+func (x *Xlm) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	return types.DecodeInto(&x.CoinValue, func(v *types.CoinValue[xlmDefinition]) error {
+		return v.UnmarshalBSONValue(t, data)
+	})
+}
+
+func NewXlm(xlm decimal.Decimal) *Xlm {
+	return &Xlm{
+		types.NewCoinValueFromCoins[xlmDefinition](xlm),
+	}
+}
+
+// NewXlmExact is like NewXlm but fails instead of truncating an amount more precise than the smallest unit.
+func NewXlmExact(xlm decimal.Decimal) (*Xlm, error) {
+	cv, err := types.NewCoinValueFromCoinsChecked[xlmDefinition](xlm)
+	if err != nil {
+		return nil, err
+	}
+	return &Xlm{cv}, nil
+}
+
+// NewXlmFromString parses a decimal string amount of lumens, e.g. "1.5".
+func NewXlmFromString(s string) (*Xlm, error) {
+	cv, err := types.ParseCoinValue[xlmDefinition](s)
+	if err != nil {
+		return nil, err
+	}
+	return &Xlm{cv}, nil
+}
+
+// MustNewXlm is like NewXlmFromString but panics if s can't be parsed, intended for tests and constants.
+func MustNewXlm(s string) *Xlm {
+	return &Xlm{types.MustParseCoinValue[xlmDefinition](s)}
+}
+
+// NewXlmFromValue creates a Xlm from a Value of the same coin, e.g. the result of Xlm.Add.
+func NewXlmFromValue(v types.Value) (*Xlm, error) {
+	cv, err := types.NewCoinValueFromValue[xlmDefinition](v)
+	if err != nil {
+		return nil, err
+	}
+	return &Xlm{cv}, nil
+}
+
+func NewXlmFromStroops(stroops *big.Int) *Xlm {
+	return &Xlm{
+		types.NewCoinValue[xlmDefinition](stroops),
+	}
+}
+
+// Stroops returns the value of the Xlm type in stroops.
+func (x Xlm) Stroops() *big.Int {
+	return x.Units()
+}
+
+// Xlm returns the value of the Xlm type in lumens.
+func (x Xlm) Xlm() decimal.Decimal {
+	return x.Coins()
+}
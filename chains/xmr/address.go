@@ -0,0 +1,68 @@
+package xmr
+
+import (
+	"bytes"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// Network is a Monero network, each network using its own address prefixes.
+type Network int
+
+const (
+	Mainnet Network = iota
+	Testnet
+	Stagenet
+)
+
+// networkBytes are the prefixes of the standard, integrated and subaddresses of each network.
+var networkBytes = map[Network][3]byte{
+	Mainnet:  {18, 19, 42},
+	Testnet:  {53, 54, 63},
+	Stagenet: {24, 25, 36},
+}
+
+const (
+	keysLen      = 2 * 32 // public spend key and public view key
+	paymentIDLen = 8
+	checksumLen  = 4
+)
+
+// IsValidAddress checks if the address is a valid Monero mainnet address.
+//
+// Standard addresses, integrated addresses and subaddresses are accepted.
+func IsValidAddress(address string) bool {
+	return IsValidNetworkAddress(address, Mainnet)
+}
+
+// IsValidNetworkAddress checks if the address is a valid Monero address on the given network.
+//
+// Standard addresses, integrated addresses and subaddresses are accepted.
+func IsValidNetworkAddress(address string, network Network) bool {
+	prefixes, ok := networkBytes[network]
+	if !ok {
+		return false
+	}
+
+	b, err := decodeBase58(address)
+	if err != nil || len(b) < 1+checksumLen {
+		return false
+	}
+
+	data, checksum := b[:len(b)-checksumLen], b[len(b)-checksumLen:]
+	h := sha3.NewLegacyKeccak256()
+	h.Write(data)
+	if !bytes.Equal(h.Sum(nil)[:checksumLen], checksum) {
+		return false
+	}
+
+	// all the network prefixes fit in a single byte varint
+	prefix, payload := data[0], data[1:]
+	switch prefix {
+	case prefixes[0], prefixes[2]:
+		return len(payload) == keysLen
+	case prefixes[1]:
+		return len(payload) == keysLen+paymentIDLen
+	}
+	return false
+}
@@ -0,0 +1,76 @@
+package xmr
+
+import "testing"
+
+// the keys of the generated vectors are the SHA-256 of "spend" and of "view"
+const (
+	standard   = "4AxSQnsnu8L3vR9P9DkWV5iT1CVYRZYFdYLEHCbT69cJ4snjCkNKqn6h3UPn6DBQQajGoJSRT9R8Kj54hyGzfGF47omudvt"
+	integrated = "4Lf7RbhHWPr3vR9P9DkWV5iT1CVYRZYFdYLEHCbT69cJ4snjCkNKqn6h3UPn6DBQQajGoJSRT9R8Kj54hyGzfGF4B37rt1rJrc4Tyfef3n"
+	subaddress = "8BnakAXdVYk3vR9P9DkWV5iT1CVYRZYFdYLEHCbT69cJ4snjCkNKqn6h3UPn6DBQQajGoJSRT9R8Kj54hyGzfGF47rYJDHk"
+	testnet    = "A2Vyu3Y4BVS3vR9P9DkWV5iT1CVYRZYFdYLEHCbT69cJ4snjCkNKqn6h3UPn6DBQQajGoJSRT9R8Kj54hyGzfGF47mgpbs1"
+	stagenet   = "5BAUVdnkYjS3vR9P9DkWV5iT1CVYRZYFdYLEHCbT69cJ4snjCkNKqn6h3UPn6DBQQajGoJSRT9R8Kj54hyGzfGF47o6yPSQ"
+
+	// the subaddress of the Monero general fund
+	generalFund = "888tNkZrPN6JsEgekjMnABU4TBzc2Dt29EPAvkRxbANsAnjyPbb3iQ1YBRk1UXcdRsiKc9dhwMVgN5S9cQUiyoogDavup3H"
+)
+
+func TestIsValidNetworkAddress(t *testing.T) {
+	tests := []struct {
+		name    string
+		address string
+		network Network
+		valid   bool
+	}{
+		{"standard", standard, Mainnet, true},
+		{"integrated", integrated, Mainnet, true},
+		{"subaddress", subaddress, Mainnet, true},
+		{"general fund", generalFund, Mainnet, true},
+		{"testnet", testnet, Testnet, true},
+		{"stagenet", stagenet, Stagenet, true},
+
+		{"testnet address on mainnet", testnet, Mainnet, false},
+		{"mainnet address on stagenet", standard, Stagenet, false},
+		{"unknown network", standard, Network(42), false},
+		{"bad checksum", standard[:len(standard)-1] + "u", Mainnet, false},
+		// a standard prefix with a payload missing a byte, and an integrated prefix without payment ID
+		{"short keys", "4AxSQnsnu8L3vR9P9DkWV5iT1CVYRZYFdYLEHCbT69cJ4snjCkNKqn6h3UPn6DBQQajGoJSRT9R8Kj54hyGzfGF41NB6Za", Mainnet, false},
+		{"integrated without payment ID", "4Lf7RbhHWPr3vR9P9DkWV5iT1CVYRZYFdYLEHCbT69cJ4snjCkNKqn6h3UPn6DBQQajGoJSRT9R8Kj54hyGzfGF47ps2rdT", Mainnet, false},
+		{"truncated", standard[:len(standard)-11], Mainnet, false},
+		{"invalid block length", standard[:len(standard)-1], Mainnet, false},
+		{"not base58", "0" + standard[1:], Mainnet, false},
+		{"empty", "", Mainnet, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsValidNetworkAddress(tt.address, tt.network); got != tt.valid {
+				t.Errorf("IsValidNetworkAddress(%q, %d) = %v, want %v", tt.address, tt.network, got, tt.valid)
+			}
+		})
+	}
+
+	if !IsValidAddress(standard) || IsValidAddress(testnet) {
+		t.Errorf("IsValidAddress() doesn't validate mainnet addresses")
+	}
+}
+
+func TestDecodeBase58(t *testing.T) {
+	tests := []struct {
+		s     string
+		valid bool
+	}{
+		{"11", true},
+		{"1111111111111111111111", true}, // two full blocks of zeros
+		{"jpXCZedGfVQ", true},            // 0xffffffffffffffff
+		{"jpXCZedGfVR", false},           // a block overflowing 64 bits
+		{"zzzzzzzzzzz", false},
+		{"1", false}, // no block is encoded in a single character
+		{"1111", false},
+		{"0I", false},
+	}
+	for _, tt := range tests {
+		_, err := decodeBase58(tt.s)
+		if (err == nil) != tt.valid {
+			t.Errorf("decodeBase58(%q) error = %v, want valid %v", tt.s, err, tt.valid)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package xmr
+
+import (
+	"encoding/binary"
+	"errors"
+	"math/bits"
+	"strings"
+)
+
+// Monero's base58 encodes data in blocks of 8 bytes, each block being encoded in exactly 11 characters,
+// so that the encoded length only depends on the data length. The last block may be shorter.
+
+const (
+	alphabet       = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+	blockSize      = 8
+	encodedBlockSz = 11
+)
+
+// encodedBlockSizes maps the size of a block in bytes to the size of its encoding.
+var encodedBlockSizes = [blockSize + 1]int{0, 2, 3, 5, 6, 7, 9, 10, 11}
+
+var errInvalidBase58 = errors.New("invalid monero base58")
+
+// decodeBase58 decodes a string encoded with Monero's base58.
+func decodeBase58(s string) ([]byte, error) {
+	out := make([]byte, 0, len(s)*blockSize/encodedBlockSz+blockSize)
+	for len(s) > 0 {
+		n := min(encodedBlockSz, len(s))
+		block, err := decodeBlock(s[:n])
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, block...)
+		s = s[n:]
+	}
+	return out, nil
+}
+
+func decodeBlock(s string) ([]byte, error) {
+	size := -1
+	for i, encodedSize := range encodedBlockSizes {
+		if encodedSize == len(s) {
+			size = i
+		}
+	}
+	if size <= 0 {
+		return nil, errInvalidBase58
+	}
+
+	var num uint64
+	for i := 0; i < len(s); i++ {
+		idx := strings.IndexByte(alphabet, s[i])
+		if idx < 0 {
+			return nil, errInvalidBase58
+		}
+		hi, lo := bits.Mul64(num, 58)
+		if hi != 0 || lo+uint64(idx) < lo {
+			return nil, errInvalidBase58
+		}
+		num = lo + uint64(idx)
+	}
+	// the number must fit in the block size
+	if size < blockSize && num>>(8*size) != 0 {
+		return nil, errInvalidBase58
+	}
+
+	var buf [blockSize]byte
+	binary.BigEndian.PutUint64(buf[:], num)
+	return buf[blockSize-size:], nil
+}
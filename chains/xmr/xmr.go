@@ -0,0 +1,122 @@
+package xmr
+
+import (
+	"math/big"
+
+	"github.com/airsigner/libcrypto/types"
+	"github.com/airsigner/libcrypto/types/pb"
+	"github.com/shopspring/decimal"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+type xmrDefinition struct{}
+
+func (xmrDefinition) CoinName() string { return "XMR" }
+func (xmrDefinition) UnitExp() int32   { return 12 }
+
+// Denominations returns piconero and XMR, see types.CoinValue.Humanize.
+func (xmrDefinition) Denominations() []types.Denomination {
+	return []types.Denomination{
+		{Name: "piconero", Exp: 0},
+		{Name: "XMR", Exp: 12},
+	}
+}
+
+// Denominations returns the denominations of XMR, from the smallest to the largest, see types.CoinValue.In.
+func Denominations() []types.Denomination {
+	return xmrDefinition{}.Denominations()
+}
+
+func init() {
+	types.Register(xmrDefinition{}.CoinName(), func(atomic *big.Int) types.Value {
+		return NewXmrFromAtomic(atomic)
+	})
+}
+
+var _ types.Value = (*Xmr)(nil)
+
+type Xmr struct {
+	*types.CoinValue[xmrDefinition]
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, see types.CoinValue.UnmarshalBinary.
+func (x *Xmr) UnmarshalBinary(data []byte) error {
+	return types.DecodeInto(&x.CoinValue, func(v *types.CoinValue[xmrDefinition]) error {
+		return v.UnmarshalBinary(data)
+	})
+}
+
+// FromProto sets the value to the value of its protobuf representation, see types.CoinValue.FromProto.
+func (x *Xmr) FromProto(m *pb.CoinValue) error {
+	return types.DecodeInto(&x.CoinValue, func(v *types.CoinValue[xmrDefinition]) error {
+		return v.FromProto(m)
+	})
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, see types.CoinValue.UnmarshalCBOR.
+func (x *Xmr) UnmarshalCBOR(data []byte) error {
+	return types.DecodeInto(&x.CoinValue, func(v *types.CoinValue[xmrDefinition]) error {
+		return v.UnmarshalCBOR(data)
+	})
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler, see types.CoinValue.UnmarshalBSONValue.
+func (x *Xmr) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	return types.DecodeInto(&x.CoinValue, func(v *types.CoinValue[xmrDefinition]) error {
+		return v.UnmarshalBSONValue(t, data)
+	})
+}
+
+func NewXmr(xmr decimal.Decimal) *Xmr {
+	return &Xmr{
+		types.NewCoinValueFromCoins[xmrDefinition](xmr),
+	}
+}
+
+// NewXmrExact is like NewXmr but fails instead of truncating an amount more precise than the smallest unit.
+func NewXmrExact(xmr decimal.Decimal) (*Xmr, error) {
+	cv, err := types.NewCoinValueFromCoinsChecked[xmrDefinition](xmr)
+	if err != nil {
+		return nil, err
+	}
+	return &Xmr{cv}, nil
+}
+
+// NewXmrFromString parses a decimal string amount of XMR, e.g. "1.5".
+func NewXmrFromString(s string) (*Xmr, error) {
+	cv, err := types.ParseCoinValue[xmrDefinition](s)
+	if err != nil {
+		return nil, err
+	}
+	return &Xmr{cv}, nil
+}
+
+// MustNewXmr is like NewXmrFromString but panics if s can't be parsed, intended for tests and constants.
+func MustNewXmr(s string) *Xmr {
+	return &Xmr{types.MustParseCoinValue[xmrDefinition](s)}
+}
+
+// NewXmrFromValue creates a Xmr from a Value of the same coin, e.g. the result of Xmr.Add.
+func NewXmrFromValue(v types.Value) (*Xmr, error) {
+	cv, err := types.NewCoinValueFromValue[xmrDefinition](v)
+	if err != nil {
+		return nil, err
+	}
+	return &Xmr{cv}, nil
+}
+
+func NewXmrFromAtomic(atomic *big.Int) *Xmr {
+	return &Xmr{
+		types.NewCoinValue[xmrDefinition](atomic),
+	}
+}
+
+// Atomic returns the value of the Xmr type in atomic units (piconero).
+func (x Xmr) Atomic() *big.Int {
+	return x.Units()
+}
+
+// Xmr returns the value of the Xmr type in Monero.
+func (x Xmr) Xmr() decimal.Decimal {
+	return x.Coins()
+}
@@ -0,0 +1,59 @@
+package xtz
+
+import (
+	"bytes"
+	"crypto/sha256"
+
+	"github.com/airsigner/libcrypto/internal/base58"
+)
+
+const (
+	hashLen     = 20
+	checksumLen = 4
+)
+
+var (
+	// implicit account prefixes, by curve of the public key hashed
+	prefixTz1 = []byte{0x06, 0xa1, 0x9f} // ed25519
+	prefixTz2 = []byte{0x06, 0xa1, 0xa1} // secp256k1
+	prefixTz3 = []byte{0x06, 0xa1, 0xa4} // p256
+
+	// originated (smart contract) account prefix
+	prefixKT1 = []byte{0x02, 0x5a, 0x79}
+)
+
+// IsValidAddress checks if the address is a valid Tezos implicit (tz1, tz2, tz3) or contract (KT1) address.
+func IsValidAddress(address string) bool {
+	prefix, ok := decodeCheck(address)
+	if !ok {
+		return false
+	}
+	for _, p := range [][]byte{prefixTz1, prefixTz2, prefixTz3, prefixKT1} {
+		if bytes.Equal(prefix, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsContractAddress checks if the address is a valid Tezos contract (KT1) address.
+func IsContractAddress(address string) bool {
+	prefix, ok := decodeCheck(address)
+	return ok && bytes.Equal(prefix, prefixKT1)
+}
+
+// decodeCheck decodes a base58check address of a 20 bytes hash, verifying its checksum, and returns its prefix.
+func decodeCheck(address string) ([]byte, bool) {
+	b, err := base58.Decode(address)
+	if err != nil || len(b) != len(prefixKT1)+hashLen+checksumLen {
+		return nil, false
+	}
+
+	data, checksum := b[:len(b)-checksumLen], b[len(b)-checksumLen:]
+	first := sha256.Sum256(data)
+	second := sha256.Sum256(first[:])
+	if !bytes.Equal(second[:checksumLen], checksum) {
+		return nil, false
+	}
+	return data[:len(prefixKT1)], true
+}
@@ -0,0 +1,37 @@
+package xtz
+
+import "testing"
+
+func TestIsValidAddress(t *testing.T) {
+	// the generated addresses hash the first 20 bytes of the SHA-256 of "key"
+	tests := []struct {
+		name     string
+		address  string
+		valid    bool
+		contract bool
+	}{
+		{"burn address", "tz1burnburnburnburnburnburnburjAYjjX", true, false},
+		{"tz1", "tz1Ph1eZzpRRWqJxwgpg1ZRrhmGP7ENT5vN8", true, false},
+		{"tz2", "tz2CNDcnbPqnSawqDrsWgCQ7FLXPd7ndQyBy", true, false},
+		{"tz3", "tz3QP2a7VFyKqDQ8e7wmg9rV4hQuPwzUwdzJ", true, false},
+		{"KT1", "KT1CdkXDUgHxbmX48b8S3v7sYKbzqnET8ywr", true, true},
+		{"tzBTC contract", "KT1PWx2mnDueood7fEmfbBDKx1D9BAnnXitn", true, true},
+
+		{"bad checksum", "tz1Ph1eZzpRRWqJxwgpg1ZRrhmGP7ELrU2ij", false, false},
+		{"altered character", "tz1Ph1eZzpRRWqJxwgpg1ZRrhmGP7ENT5vN9", false, false},
+		{"unknown prefix", "11153yxT8bnqmZ1ZFZK9x1Pkg8NNo2kWueAc", false, false},
+		{"short hash", "Cn65EbatRt5B5wu6suXHYPkhG8Jz7umcfEc", false, false},
+		{"not base58", "tz1Ph1eZzpRRWqJxwgpg1ZRrhmGP7ENT5vN0", false, false},
+		{"empty", "", false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsValidAddress(tt.address); got != tt.valid {
+				t.Errorf("IsValidAddress(%q) = %v, want %v", tt.address, got, tt.valid)
+			}
+			if got := IsContractAddress(tt.address); got != tt.contract {
+				t.Errorf("IsContractAddress(%q) = %v, want %v", tt.address, got, tt.contract)
+			}
+		})
+	}
+}
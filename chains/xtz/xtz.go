@@ -0,0 +1,122 @@
+package xtz
+
+import (
+	"math/big"
+
+	"github.com/airsigner/libcrypto/types"
+	"github.com/airsigner/libcrypto/types/pb"
+	"github.com/shopspring/decimal"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+type xtzDefinition struct{}
+
+func (xtzDefinition) CoinName() string { return "XTZ" }
+func (xtzDefinition) UnitExp() int32   { return 6 }
+
+// Denominations returns mutez and XTZ, see types.CoinValue.Humanize.
+func (xtzDefinition) Denominations() []types.Denomination {
+	return []types.Denomination{
+		{Name: "mutez", Exp: 0},
+		{Name: "XTZ", Exp: 6},
+	}
+}
+
+// Denominations returns the denominations of XTZ, from the smallest to the largest, see types.CoinValue.In.
+func Denominations() []types.Denomination {
+	return xtzDefinition{}.Denominations()
+}
+
+func init() {
+	types.Register(xtzDefinition{}.CoinName(), func(mutez *big.Int) types.Value {
+		return NewXtzFromMutez(mutez)
+	})
+}
+
+var _ types.Value = (*Xtz)(nil)
+
+type Xtz struct {
+	*types.CoinValue[xtzDefinition]
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, see types.CoinValue.UnmarshalBinary.
+func (x *Xtz) UnmarshalBinary(data []byte) error {
+	return types.DecodeInto(&x.CoinValue, func(v *types.CoinValue[xtzDefinition]) error {
+		return v.UnmarshalBinary(data)
+	})
+}
+
+// FromProto sets the value to the value of its protobuf representation, see types.CoinValue.FromProto.
+func (x *Xtz) FromProto(m *pb.CoinValue) error {
+	return types.DecodeInto(&x.CoinValue, func(v *types.CoinValue[xtzDefinition]) error {
+		return v.FromProto(m)
+	})
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, see types.CoinValue.UnmarshalCBOR.
+func (x *Xtz) UnmarshalCBOR(data []byte) error {
+	return types.DecodeInto(&x.CoinValue, func(v *types.CoinValue[xtzDefinition]) error {
+		return v.UnmarshalCBOR(data)
+	})
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler, see types.CoinValue.UnmarshalBSONValue.
+func (x *Xtz) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	return types.DecodeInto(&x.CoinValue, func(v *types.CoinValue[xtzDefinition]) error {
+		return v.UnmarshalBSONValue(t, data)
+	})
+}
+
+func NewXtz(xtz decimal.Decimal) *Xtz {
+	return &Xtz{
+		types.NewCoinValueFromCoins[xtzDefinition](xtz),
+	}
+}
+
+// NewXtzExact is like NewXtz but fails instead of truncating an amount more precise than the smallest unit.
+func NewXtzExact(xtz decimal.Decimal) (*Xtz, error) {
+	cv, err := types.NewCoinValueFromCoinsChecked[xtzDefinition](xtz)
+	if err != nil {
+		return nil, err
+	}
+	return &Xtz{cv}, nil
+}
+
+// NewXtzFromString parses a decimal string amount of XTZ, e.g. "1.5".
+func NewXtzFromString(s string) (*Xtz, error) {
+	cv, err := types.ParseCoinValue[xtzDefinition](s)
+	if err != nil {
+		return nil, err
+	}
+	return &Xtz{cv}, nil
+}
+
+// MustNewXtz is like NewXtzFromString but panics if s can't be parsed, intended for tests and constants.
+func MustNewXtz(s string) *Xtz {
+	return &Xtz{types.MustParseCoinValue[xtzDefinition](s)}
+}
+
+// NewXtzFromValue creates a Xtz from a Value of the same coin, e.g. the result of Xtz.Add.
+func NewXtzFromValue(v types.Value) (*Xtz, error) {
+	cv, err := types.NewCoinValueFromValue[xtzDefinition](v)
+	if err != nil {
+		return nil, err
+	}
+	return &Xtz{cv}, nil
+}
+
+func NewXtzFromMutez(mutez *big.Int) *Xtz {
+	return &Xtz{
+		types.NewCoinValue[xtzDefinition](mutez),
+	}
+}
+
+// Mutez returns the value of the Xtz type in mutez.
+func (x Xtz) Mutez() *big.Int {
+	return x.Units()
+}
+
+// Xtz returns the value of the Xtz type in XTZ.
+func (x Xtz) Xtz() decimal.Decimal {
+	return x.Coins()
+}
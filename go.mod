@@ -4,7 +4,12 @@ go 1.22.3
 
 require (
 	github.com/ethereum/go-ethereum v1.14.3
+	github.com/fxamacker/cbor/v2 v2.7.0
 	github.com/shopspring/decimal v1.4.0
+	go.mongodb.org/mongo-driver v1.17.6
+	golang.org/x/crypto v0.26.0
+	golang.org/x/net v0.25.0
+	google.golang.org/protobuf v1.33.0
 )
 
 require (
@@ -26,10 +31,11 @@ require (
 	github.com/supranational/blst v0.3.11 // indirect
 	github.com/tklauser/go-sysconf v0.3.12 // indirect
 	github.com/tklauser/numcpus v0.6.1 // indirect
-	golang.org/x/crypto v0.22.0 // indirect
+	github.com/x448/float16 v0.8.4 // indirect
 	golang.org/x/mod v0.17.0 // indirect
-	golang.org/x/sync v0.7.0 // indirect
-	golang.org/x/sys v0.19.0 // indirect
-	golang.org/x/tools v0.20.0 // indirect
+	golang.org/x/sync v0.8.0 // indirect
+	golang.org/x/sys v0.23.0 // indirect
+	golang.org/x/text v0.17.0 // indirect
+	golang.org/x/tools v0.21.1-0.20240508182429-e35e4ccd0d2d // indirect
 	rsc.io/tmplfunc v0.0.3 // indirect
 )
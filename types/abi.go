@@ -0,0 +1,23 @@
+package types
+
+import "fmt"
+
+// PackUint256 returns the Solidity ABI encoding of the units of the value as a uint256,
+// i.e. 32 bytes in big-endian.
+//
+// Parameters:
+// - v: the value to pack.
+//
+// Returns:
+// - []byte: the 32 bytes encoding.
+// - error: ErrOutOfRange if the units are negative or don't fit in 256 bits, ErrNilValue if the value is nil.
+func PackUint256(v Value) ([]byte, error) {
+	if IsNil(v) {
+		return nil, fmt.Errorf("%w: cannot pack nil as a uint256", ErrNilValue)
+	}
+	units := v.Units()
+	if units.Sign() < 0 || units.BitLen() > 256 {
+		return nil, fmt.Errorf("%w: %s %s units don't fit in a uint256", ErrOutOfRange, units, v.CoinName())
+	}
+	return units.FillBytes(make([]byte, 32)), nil
+}
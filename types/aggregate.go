@@ -0,0 +1,83 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// Sum returns the sum of values.
+//
+// Parameters:
+// - values: the values to sum, all of the same coin.
+//
+// Returns:
+// - Value: the sum of the values.
+// - error: an error if there are no values, or ErrCoinMismatch if the values aren't all of the same coin.
+func Sum(values ...Value) (Value, error) {
+	if len(values) == 0 {
+		return nil, errors.New("cannot sum no values")
+	}
+
+	sum := values[0]
+	for _, value := range values[1:] {
+		if !value.Same(sum) {
+			return nil, NewMismatchError("sum", sum, value)
+		}
+		sum = sum.Add(value)
+	}
+	return sum, nil
+}
+
+// MustSum is like Sum but panics if the values can't be summed.
+//
+// It is intended for tests and constants, where the values are known to be of the same coin.
+func MustSum(values ...Value) Value {
+	sum, err := Sum(values...)
+	if err != nil {
+		panic(err)
+	}
+	return sum
+}
+
+// WeightedAverage returns the average of values weighted by weights, i.e. sum(v_i*w_i)/sum(w_i),
+// e.g. the average gas price of several transactions weighted by their gas used.
+//
+// The computation is done in units, and the final division rounds like DivScalar,
+// i.e. down to the unit, toward negative infinity.
+//
+// Parameters:
+// - values: the values to average, all of the same coin.
+// - weights: the non-negative weight of each value.
+//
+// Returns:
+// - Value: the weighted average.
+// - error: an error if the slices are empty or of different lengths, if a weight is negative,
+// if the weights sum to zero, or ErrCoinMismatch if the values aren't all of the same coin.
+func WeightedAverage(values []Value, weights []*big.Int) (Value, error) {
+	if len(values) != len(weights) {
+		return nil, fmt.Errorf("got %d values but %d weights", len(values), len(weights))
+	}
+	if len(values) == 0 {
+		return nil, errors.New("cannot average no values")
+	}
+
+	sum := values[0].MulScalar(big.NewInt(0))
+	totalWeight := new(big.Int)
+	for i, value := range values {
+		if !value.Same(sum) {
+			return nil, NewMismatchError("average", sum, value)
+		}
+		if weights[i].Sign() < 0 {
+			return nil, fmt.Errorf("negative weight %s", weights[i])
+		}
+
+		sum = sum.Add(value.MulScalar(weights[i]))
+		totalWeight.Add(totalWeight, weights[i])
+	}
+
+	if totalWeight.Sign() == 0 {
+		return nil, errors.New("total weight is zero")
+	}
+	return sum.DivScalar(totalWeight), nil
+}
@@ -0,0 +1,55 @@
+package types
+
+import "math/big"
+
+// Balance is a running total of a coin, e.g. to reconstruct a ledger by replaying a stream of deltas.
+//
+// Unlike repeated calls to Add, applying a delta doesn't allocate: the total is kept in a single reused big.Int.
+// The zero value is a zero Balance without history, ready to use. A Balance isn't safe for concurrent use.
+type Balance[D ValueDefinition] struct {
+	total       big.Int
+	keepHistory bool
+	history     []Value
+}
+
+// NewBalance creates a zero Balance.
+//
+// Parameters:
+// - keepHistory: whether to record the total after each delta, see History.
+//
+// Returns:
+// - *Balance[D]: the new Balance.
+func NewBalance[D ValueDefinition](keepHistory bool) *Balance[D] {
+	return &Balance[D]{keepHistory: keepHistory}
+}
+
+// Apply adds a delta to the Balance, a negative delta being a debit.
+//
+// Parameters:
+// - delta: the Value to add.
+//
+// Returns:
+// - error: a *MismatchError if the delta is of a different coin, or ErrNilValue if it is nil,
+// in which case the Balance is left unchanged.
+func (b *Balance[D]) Apply(delta Value) error {
+	var zero CoinValue[D]
+	if err := zero.check("apply", delta); err != nil {
+		return err
+	}
+
+	b.total.Add(&b.total, delta.Units())
+	if b.keepHistory {
+		b.history = append(b.history, b.Current())
+	}
+	return nil
+}
+
+// Current returns the current total of the Balance.
+func (b *Balance[D]) Current() Value {
+	return NewCoinValue[D](new(big.Int).Set(&b.total))
+}
+
+// History returns the total after each applied delta, oldest first, or nil if the history isn't kept.
+func (b *Balance[D]) History() []Value {
+	return b.history
+}
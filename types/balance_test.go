@@ -0,0 +1,73 @@
+package types
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func TestBalanceApply(t *testing.T) {
+	b := NewBalance[testDefinition](true)
+	for _, delta := range []int64{100, -30, 5, -75} {
+		if err := b.Apply(units(delta)); err != nil {
+			t.Fatalf("Apply(%d) error = %v", delta, err)
+		}
+	}
+
+	if got := b.Current().Units(); got.Sign() != 0 {
+		t.Errorf("Current() = %s units, want 0", got)
+	}
+	want := []int64{100, 70, 75, 0}
+	history := b.History()
+	if len(history) != len(want) {
+		t.Fatalf("History() has %d entries, want %d", len(history), len(want))
+	}
+	for i, v := range history {
+		if v.Units().Int64() != want[i] {
+			t.Errorf("History()[%d] = %s units, want %d", i, v.Units(), want[i])
+		}
+	}
+}
+
+func TestBalanceCurrentIsACopy(t *testing.T) {
+	var b Balance[testDefinition]
+	if err := b.Apply(units(10)); err != nil {
+		t.Fatal(err)
+	}
+	current := b.Current()
+	if err := b.Apply(units(5)); err != nil {
+		t.Fatal(err)
+	}
+	if current.Units().Int64() != 10 || b.Current().Units().Int64() != 15 {
+		t.Errorf("Current() = %s units after another Apply, want 10", current.Units())
+	}
+	if b.History() != nil {
+		t.Errorf("History() = %v without keepHistory, want nil", b.History())
+	}
+}
+
+func TestBalanceApplyRejects(t *testing.T) {
+	b := NewBalance[testDefinition](true)
+	if err := b.Apply(units(10)); err != nil {
+		t.Fatal(err)
+	}
+
+	var mismatch *MismatchError
+	if err := b.Apply(NewCoinValue[otherDefinition](big.NewInt(1))); !errors.As(err, &mismatch) {
+		t.Errorf("Apply() of another coin = %v, want a *MismatchError", err)
+	}
+	if err := b.Apply((*CoinValue[testDefinition])(nil)); !errors.Is(err, ErrNilValue) {
+		t.Errorf("Apply(nil) = %v, want ErrNilValue", err)
+	}
+	if got := b.Current().Units().Int64(); got != 10 || len(b.History()) != 1 {
+		t.Errorf("failed Apply() modified the Balance: %d units, %d history entries", got, len(b.History()))
+	}
+}
+
+func TestBalanceApplyAllocs(t *testing.T) {
+	var b Balance[testDefinition]
+	delta := units(1)
+	if allocs := testing.AllocsPerRun(100, func() { _ = b.Apply(delta) }); allocs != 0 {
+		t.Errorf("Apply() allocates %.0f times, want 0", allocs)
+	}
+}
@@ -0,0 +1,113 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// bsonValue is the BSON form of a CoinValue, a document with the coin name and the units.
+type bsonValue struct {
+	Coin  string        `bson:"coin"`
+	Units bson.RawValue `bson:"units"`
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+//
+// The CoinValue is encoded as the document {coin: coin name, units: units}, the units being a Decimal128,
+// so that MongoDB can compare and range query them, or a string when they have more than the 34 significant
+// digits of a Decimal128.
+func (v CoinValue[D]) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	var units any
+	if d, ok := primitive.ParseDecimal128FromBigInt(v.Units(), 0); ok {
+		units = d
+	} else {
+		units = v.Units().String()
+	}
+
+	data, err := bson.Marshal(bson.D{{Key: "coin", Value: v.CoinName()}, {Key: "units", Value: units}})
+	if err != nil {
+		return 0, nil, err
+	}
+	return bson.TypeEmbeddedDocument, data, nil
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+//
+// It decodes the encoding produced by MarshalBSONValue, failing if the encoded coin isn't the coin of the CoinValue
+// or if the units aren't an integer.
+//
+// Chain types embedding a *CoinValue must implement it themselves with DecodeInto, see there.
+func (v *CoinValue[D]) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	if v == nil {
+		return fmt.Errorf("%w: cannot decode into a nil CoinValue", ErrNilValue)
+	}
+	var def D
+	if t != bson.TypeEmbeddedDocument {
+		return fmt.Errorf("cannot decode BSON %s into %s", t, def.CoinName())
+	}
+
+	var bv bsonValue
+	if err := bson.Unmarshal(data, &bv); err != nil {
+		return err
+	}
+	if bv.Coin != def.CoinName() {
+		return fmt.Errorf("%w: cannot decode %s into %s", ErrCoinMismatch, bv.Coin, def.CoinName())
+	}
+
+	units, err := bsonUnits(bv.Units)
+	if err != nil {
+		return fmt.Errorf("invalid %s units: %w", def.CoinName(), err)
+	}
+	v.value = units
+	v.coins = new(coinsMemo)
+	return nil
+}
+
+// bsonUnits decodes the units of a CoinValue from a BSON Decimal128 or string, or from a BSON integer
+// for documents written by hand.
+func bsonUnits(raw bson.RawValue) (*big.Int, error) {
+	switch raw.Type {
+	case bson.TypeDecimal128:
+		coefficient, exp, err := raw.Decimal128().BigInt()
+		if err != nil {
+			return nil, err
+		}
+		units := decimalUnits(coefficient, exp)
+		if units == nil {
+			return nil, fmt.Errorf("%s is not an integer", raw.Decimal128())
+		}
+		return units, nil
+	case bson.TypeString:
+		units, ok := new(big.Int).SetString(raw.StringValue(), 10)
+		if !ok {
+			return nil, fmt.Errorf("%q is not an integer", raw.StringValue())
+		}
+		return units, nil
+	case bson.TypeInt32:
+		return big.NewInt(int64(raw.Int32())), nil
+	case bson.TypeInt64:
+		return big.NewInt(raw.Int64()), nil
+	default:
+		return nil, fmt.Errorf("unexpected BSON %s", raw.Type)
+	}
+}
+
+// decimalUnits returns coefficient*10^exp, or nil if it isn't an integer.
+func decimalUnits(coefficient *big.Int, exp int) *big.Int {
+	ten := big.NewInt(10)
+	for ; exp < 0; exp++ {
+		var r big.Int
+		coefficient.QuoRem(coefficient, ten, &r)
+		if r.Sign() != 0 {
+			return nil
+		}
+	}
+	if exp > 0 {
+		coefficient.Mul(coefficient, new(big.Int).Exp(ten, big.NewInt(int64(exp)), nil))
+	}
+	return coefficient
+}
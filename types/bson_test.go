@@ -0,0 +1,153 @@
+package types
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// otherDefinition is the definition of a second test coin, for the coin mismatch checks.
+type otherDefinition struct{}
+
+func (otherDefinition) CoinName() string { return "OTH" }
+func (otherDefinition) UnitExp() int32   { return 18 }
+
+type bsonDocument struct {
+	Amount *CoinValue[testDefinition] `bson:"amount"`
+}
+
+func TestBSONRoundTrip(t *testing.T) {
+	max34, _ := new(big.Int).SetString(strings.Repeat("9", 34), 10)
+	tests := []struct {
+		name      string
+		units     *big.Int
+		unitsType bsontype.Type
+	}{
+		{"zero", big.NewInt(0), bson.TypeDecimal128},
+		{"one coin", big.NewInt(1_000_000_000_000_000_000), bson.TypeDecimal128},
+		{"negative", big.NewInt(-1_500_000_000_000_000_000), bson.TypeDecimal128},
+		{"34 digits", max34, bson.TypeDecimal128},
+		{"-34 digits", new(big.Int).Neg(max34), bson.TypeDecimal128},
+		// 10^34, exactly represented with an exponent
+		{"35 digits", new(big.Int).Add(max34, big.NewInt(1)), bson.TypeDecimal128},
+		{"35 significant digits", new(big.Int).Add(max34, big.NewInt(2)), bson.TypeString},
+		{"uint256 max", new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1)), bson.TypeString},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := bson.Marshal(bsonDocument{Amount: NewCoinValue[testDefinition](tt.units)})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			raw := bson.Raw(data)
+			if coin := raw.Lookup("amount", "coin").StringValue(); coin != "TST" {
+				t.Errorf("coin = %q, want TST", coin)
+			}
+			if got := raw.Lookup("amount", "units").Type; got != tt.unitsType {
+				t.Errorf("units encoded as BSON %s, want %s", got, tt.unitsType)
+			}
+
+			var doc bsonDocument
+			if err := bson.Unmarshal(data, &doc); err != nil {
+				t.Fatal(err)
+			}
+			if doc.Amount.Units().Cmp(tt.units) != 0 {
+				t.Errorf("round trip = %s units, want %s", doc.Amount.Units(), tt.units)
+			}
+			if want := NewCoinValue[testDefinition](tt.units).Coins(); !doc.Amount.Coins().Equal(want) {
+				t.Errorf("Coins() after the round trip = %s, want %s", doc.Amount.Coins(), want)
+			}
+		})
+	}
+}
+
+func TestBSONUnitsForms(t *testing.T) {
+	decimal128 := func(s string) primitive.Decimal128 {
+		d, err := primitive.ParseDecimal128(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return d
+	}
+	tests := []struct {
+		name  string
+		units any
+		want  int64
+	}{
+		{"decimal128", decimal128("150"), 150},
+		{"decimal128 with positive exponent", decimal128("1.5E+2"), 150},
+		{"decimal128 with trailing zeros", decimal128("150.000"), 150},
+		{"string", "150", 150},
+		{"negative string", "-150", -150},
+		{"int32", int32(150), 150},
+		{"int64", int64(-150), -150},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := bson.Marshal(bson.D{{Key: "amount", Value: bson.D{{Key: "coin", Value: "TST"}, {Key: "units", Value: tt.units}}}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			var doc bsonDocument
+			if err := bson.Unmarshal(data, &doc); err != nil {
+				t.Fatal(err)
+			}
+			if doc.Amount.Units().Int64() != tt.want {
+				t.Errorf("units = %s, want %d", doc.Amount.Units(), tt.want)
+			}
+		})
+	}
+}
+
+func TestBSONUnmarshalErrors(t *testing.T) {
+	decodeAmount := func(amount any) error {
+		data, err := bson.Marshal(bson.D{{Key: "amount", Value: amount}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var doc bsonDocument
+		return bson.Unmarshal(data, &doc)
+	}
+	fraction, _ := primitive.ParseDecimal128("1.5")
+	tests := []struct {
+		name   string
+		amount any
+	}{
+		{"not a document", "150"},
+		{"decimal128 fraction", bson.D{{Key: "coin", Value: "TST"}, {Key: "units", Value: fraction}}},
+		{"not an integer string", bson.D{{Key: "coin", Value: "TST"}, {Key: "units", Value: "1.5"}}},
+		{"double units", bson.D{{Key: "coin", Value: "TST"}, {Key: "units", Value: 150.0}}},
+		{"missing units", bson.D{{Key: "coin", Value: "TST"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := decodeAmount(tt.amount); err == nil {
+				t.Error("Unmarshal() succeeded, want an error")
+			}
+		})
+	}
+
+	t.Run("coin mismatch", func(t *testing.T) {
+		err := decodeAmount(NewCoinValue[otherDefinition](big.NewInt(150)))
+		if !errors.Is(err, ErrCoinMismatch) {
+			t.Errorf("error = %v, want ErrCoinMismatch", err)
+		}
+	})
+
+	t.Run("nil receiver", func(t *testing.T) {
+		typ, data, err := units(150).MarshalBSONValue()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var v *CoinValue[testDefinition]
+		if err := v.UnmarshalBSONValue(typ, data); !errors.Is(err, ErrNilValue) {
+			t.Errorf("UnmarshalBSONValue() into nil = %v, want ErrNilValue", err)
+		}
+	})
+}
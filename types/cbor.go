@@ -0,0 +1,58 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/fxamacker/cbor/v2"
+)
+
+// maxCBORSize is the maximum size of an encoded CoinValue accepted by UnmarshalCBOR, far above the size
+// of any real amount, so that decoding an untrusted message never parses an arbitrarily large bignum.
+const maxCBORSize = 1024
+
+// cborValue is the CBOR form of a CoinValue, a map with integer keys to keep it compact.
+type cborValue struct {
+	Coin  string   `cbor:"1,keyasint"`
+	Units *big.Int `cbor:"2,keyasint"`
+}
+
+// MarshalCBOR implements cbor.Marshaler.
+//
+// The CoinValue is encoded as the map {1: coin name, 2: units}, the units being an integer,
+// or a bignum (RFC 8949 tag 2 or 3) when they don't fit in 64 bits.
+func (v CoinValue[D]) MarshalCBOR() ([]byte, error) {
+	return cbor.Marshal(cborValue{Coin: v.CoinName(), Units: v.Units()})
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler.
+//
+// It decodes the encoding produced by MarshalCBOR, failing if the encoded coin isn't the coin of the CoinValue
+// or if the encoding is larger than 1 KiB.
+//
+// Chain types embedding a *CoinValue must implement it themselves with DecodeInto, see there.
+func (v *CoinValue[D]) UnmarshalCBOR(data []byte) error {
+	if v == nil {
+		return fmt.Errorf("%w: cannot decode into a nil CoinValue", ErrNilValue)
+	}
+	if len(data) > maxCBORSize {
+		return errors.New("CBOR value too large")
+	}
+
+	var cv cborValue
+	if err := cbor.Unmarshal(data, &cv); err != nil {
+		return err
+	}
+	var def D
+	if cv.Coin != def.CoinName() {
+		return fmt.Errorf("%w: cannot decode %s into %s", ErrCoinMismatch, cv.Coin, def.CoinName())
+	}
+
+	if cv.Units == nil {
+		cv.Units = new(big.Int)
+	}
+	v.value = cv.Units
+	v.coins = new(coinsMemo)
+	return nil
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/fxamacker/cbor/v2"
+)
+
+func TestCBORRoundTrip(t *testing.T) {
+	huge, _ := new(big.Int).SetString("-123456789012345678901234567890123456789012345678901234567890", 10)
+	for _, want := range []*big.Int{big.NewInt(0), big.NewInt(1), big.NewInt(-1), big.NewInt(1e18), huge} {
+		data, err := cbor.Marshal(NewCoinValue[testDefinition](want))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got CoinValue[testDefinition]
+		if err := cbor.Unmarshal(data, &got); err != nil {
+			t.Fatalf("Unmarshal() of %s: %v", want, err)
+		}
+		if got.Units().Cmp(want) != 0 {
+			t.Errorf("round trip of %s = %s", want, got.Units())
+		}
+	}
+}
+
+func TestUnmarshalCBORRejects(t *testing.T) {
+	wrongCoin, err := cbor.Marshal(NewCoinValue[otherDefinition](big.NewInt(1)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	oversized, err := cbor.Marshal(cborValue{Coin: "TST", Units: new(big.Int).Lsh(big.NewInt(1), 8*maxCBORSize)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		data    []byte
+		wantErr error
+	}{
+		{"wrong coin", wrongCoin, ErrCoinMismatch},
+		{"oversized", oversized, nil},
+		{"not a map", []byte{0x01}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := units(7)
+			err := v.UnmarshalCBOR(tt.data)
+			if err == nil || (tt.wantErr != nil && !errors.Is(err, tt.wantErr)) {
+				t.Errorf("UnmarshalCBOR() = %v, want an error", err)
+			}
+			if v.Units().Int64() != 7 {
+				t.Errorf("a failed UnmarshalCBOR() modified the value: %s", v.Units())
+			}
+		})
+	}
+
+	var v *CoinValue[testDefinition]
+	if err := v.UnmarshalCBOR(wrongCoin); !errors.Is(err, ErrNilValue) {
+		t.Errorf("UnmarshalCBOR() into nil = %v, want ErrNilValue", err)
+	}
+}
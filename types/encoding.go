@@ -0,0 +1,110 @@
+package types
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+const (
+	signPositive byte = 0
+	signNegative byte = 1
+)
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+//
+// The encoding is a sign byte (0 for positive or zero, 1 for negative),
+// the length of the magnitude as a uvarint, the magnitude in big-endian and finally the coin name.
+func (v CoinValue[D]) MarshalBinary() ([]byte, error) {
+	magnitude := v.Units().Bytes()
+	name := v.CoinName()
+
+	b := make([]byte, 0, 1+binary.MaxVarintLen64+len(magnitude)+len(name))
+	if v.Units().Sign() < 0 {
+		b = append(b, signNegative)
+	} else {
+		b = append(b, signPositive)
+	}
+	b = binary.AppendUvarint(b, uint64(len(magnitude)))
+	b = append(b, magnitude...)
+	b = append(b, name...)
+	return b, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+//
+// It decodes the encoding produced by MarshalBinary, failing if the encoded coin isn't the coin of the CoinValue.
+// Only the canonical encoding is accepted, so that every value has a single encoding: the length must be a minimal
+// uvarint, the magnitude must have no leading zero bytes and zero must not be negative.
+//
+// Chain types embedding a *CoinValue must implement it themselves with DecodeInto, see there.
+func (v *CoinValue[D]) UnmarshalBinary(data []byte) error {
+	if v == nil {
+		return fmt.Errorf("%w: cannot decode into a nil CoinValue", ErrNilValue)
+	}
+	if len(data) == 0 {
+		return errors.New("empty binary value")
+	}
+
+	sign := data[0]
+	if sign != signPositive && sign != signNegative {
+		return fmt.Errorf("invalid sign byte %d", sign)
+	}
+
+	n, read := binary.Uvarint(data[1:])
+	if read <= 0 || n > uint64(len(data)-1-read) {
+		return errors.New("invalid magnitude length")
+	}
+	if read != len(binary.AppendUvarint(nil, n)) {
+		return errors.New("non-canonical magnitude length")
+	}
+	magnitude := data[1+read : 1+read+int(n)]
+	if len(magnitude) > 0 && magnitude[0] == 0 {
+		return errors.New("non-canonical magnitude with leading zeros")
+	}
+	if len(magnitude) == 0 && sign == signNegative {
+		return errors.New("non-canonical negative zero")
+	}
+	name := string(data[1+read+int(n):])
+
+	var def D
+	if name != def.CoinName() {
+		return fmt.Errorf("%w: cannot decode %s into %s", ErrCoinMismatch, name, def.CoinName())
+	}
+
+	value := new(big.Int).SetBytes(magnitude)
+	if sign == signNegative {
+		value.Neg(value)
+	}
+	v.value = value
+	v.coins = new(coinsMemo)
+	return nil
+}
+
+// DecodeInto decodes a value into the *CoinValue embedded in a chain type, e.g. eth.Eth.
+//
+// The decoding methods of CoinValue, e.g. UnmarshalBinary, set their receiver, so they can't be promoted
+// to the zero value of a chain type, whose embedded *CoinValue is nil. Chain types implement them
+// by decoding into a new CoinValue with DecodeInto, which replaces the embedded one only if decoding succeeds:
+//
+//	func (e *Eth) UnmarshalBinary(data []byte) error {
+//		return types.DecodeInto(&e.CoinValue, func(v *types.CoinValue[ethDefinition]) error {
+//			return v.UnmarshalBinary(data)
+//		})
+//	}
+//
+// Parameters:
+// - dst: the embedded *CoinValue, which may be nil.
+// - decode: the function decoding into a new CoinValue.
+//
+// Returns:
+// - error: the error of decode, in which case dst is left unchanged.
+func DecodeInto[D ValueDefinition](dst **CoinValue[D], decode func(v *CoinValue[D]) error) error {
+	v := new(CoinValue[D])
+	if err := decode(v); err != nil {
+		return err
+	}
+	*dst = v
+	return nil
+}
@@ -0,0 +1,122 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func TestUnmarshalBinaryRejectsNonCanonical(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"empty", nil},
+		{"invalid sign", []byte{2, 0, 'T', 'S', 'T'}},
+		{"negative zero", []byte{signNegative, 0, 'T', 'S', 'T'}},
+		{"leading zero byte", []byte{signPositive, 2, 0, 1, 'T', 'S', 'T'}},
+		{"zero magnitude byte", []byte{signPositive, 1, 0, 'T', 'S', 'T'}},
+		{"non-minimal length", []byte{signPositive, 0x81, 0x00, 1, 'T', 'S', 'T'}},
+		{"length past the end", []byte{signPositive, 9, 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var v CoinValue[testDefinition]
+			if err := v.UnmarshalBinary(tt.data); err == nil {
+				t.Errorf("UnmarshalBinary(%x) = %s, want an error", tt.data, v.Units())
+			}
+		})
+	}
+}
+
+func TestUnmarshalBinaryCoinMismatch(t *testing.T) {
+	var v CoinValue[testDefinition]
+	if err := v.UnmarshalBinary([]byte{signPositive, 1, 1, 'E', 'T', 'H'}); !errors.Is(err, ErrCoinMismatch) {
+		t.Errorf("UnmarshalBinary() of an ETH value = %v, want ErrCoinMismatch", err)
+	}
+}
+
+func FuzzBinaryRoundTrip(f *testing.F) {
+	f.Add([]byte{}, false)
+	f.Add([]byte{1}, true)
+	f.Add([]byte{0xde, 0x0b, 0x6b, 0x3a, 0x76, 0x40, 0x00, 0x00}, false)
+	f.Add(bytes.Repeat([]byte{0xff}, 200), true)
+
+	f.Fuzz(func(t *testing.T, magnitude []byte, negative bool) {
+		want := new(big.Int).SetBytes(magnitude)
+		if negative {
+			want.Neg(want)
+		}
+
+		data, err := NewCoinValue[testDefinition](want).MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got CoinValue[testDefinition]
+		if err := got.UnmarshalBinary(data); err != nil {
+			t.Fatalf("UnmarshalBinary(%x): %v", data, err)
+		}
+		if got.Units().Cmp(want) != 0 {
+			t.Fatalf("round trip of %s = %s", want, got.Units())
+		}
+
+		again, err := got.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(again, data) {
+			t.Fatalf("encoding of %s isn't stable: %x then %x", want, data, again)
+		}
+	})
+}
+
+func FuzzUnmarshalBinaryCanonical(f *testing.F) {
+	f.Add([]byte{signPositive, 0, 'T', 'S', 'T'})
+	f.Add([]byte{signNegative, 1, 7, 'T', 'S', 'T'})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var v CoinValue[testDefinition]
+		if err := v.UnmarshalBinary(data); err != nil {
+			return
+		}
+		// any accepted encoding must be the one MarshalBinary produces
+		encoded, err := v.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(encoded, data) {
+			t.Fatalf("UnmarshalBinary accepted the non-canonical encoding %x of %x", data, encoded)
+		}
+	})
+}
+
+func TestUnmarshalBinaryNilReceiver(t *testing.T) {
+	var v *CoinValue[testDefinition]
+	if err := v.UnmarshalBinary([]byte{signPositive, 0, 'T', 'S', 'T'}); !errors.Is(err, ErrNilValue) {
+		t.Errorf("UnmarshalBinary() into nil = %v, want ErrNilValue", err)
+	}
+}
+
+func TestDecodeInto(t *testing.T) {
+	data, err := units(1500).MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var dst *CoinValue[testDefinition]
+	if err := DecodeInto(&dst, func(v *CoinValue[testDefinition]) error { return v.UnmarshalBinary(data) }); err != nil {
+		t.Fatal(err)
+	}
+	if dst == nil || dst.Units().Int64() != 1500 {
+		t.Fatalf("DecodeInto() = %v, want 1500 units", dst)
+	}
+
+	kept := dst
+	if err := DecodeInto(&dst, func(v *CoinValue[testDefinition]) error { return v.UnmarshalBinary(nil) }); err == nil {
+		t.Fatal("DecodeInto() of invalid data succeeded")
+	}
+	if dst != kept || dst.Units().Int64() != 1500 {
+		t.Errorf("DecodeInto() modified the destination on failure: %v", dst)
+	}
+}
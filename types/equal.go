@@ -0,0 +1,21 @@
+package types
+
+// ValuesEqual checks if two Values hold the same amount of the same coin.
+//
+// Unlike comparing the Coins() strings, the comparison is done on the units,
+// so values differing by a single unit are never considered equal.
+// Two nil Values are equal, a nil Value is never equal to a non-nil one, nil pointers counting as nil Values.
+//
+// Parameters:
+// - a: the first Value to compare.
+// - b: the second Value to compare.
+//
+// Returns:
+// - bool: true if both values are of the same coin and have the same units, false otherwise.
+func ValuesEqual(a, b Value) bool {
+	if IsNil(a) || IsNil(b) {
+		return IsNil(a) && IsNil(b)
+	}
+
+	return a.Same(b) && a.Units().Cmp(b.Units()) == 0
+}
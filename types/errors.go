@@ -0,0 +1,84 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+var (
+	// ErrCoinMismatch is returned when an operation combines values of different coins.
+	ErrCoinMismatch = errors.New("coin mismatch")
+
+	// ErrNilValue is returned when an operation is given a nil Value, or a nil pointer as a Value.
+	ErrNilValue = errors.New("nil value")
+
+	// ErrOutOfRange is returned when a value is outside of the range allowed by its definition.
+	ErrOutOfRange = errors.New("value out of range")
+
+	// ErrInsufficientFunds is returned when inputs can't cover a payment.
+	ErrInsufficientFunds = errors.New("insufficient funds")
+)
+
+// MismatchError is the error of an operation combining values of different coins.
+//
+// It is returned by the checked methods and is the value of the panics of the unchecked ones.
+// It wraps ErrCoinMismatch, so errors.Is(err, ErrCoinMismatch) holds.
+type MismatchError struct {
+	// Op is the operation, e.g. "add".
+	Op string
+	// Left is the coin name of the receiver of the operation, prefixed by its namespace if any, e.g. "ethereum-mainnet/ETH".
+	Left string
+	// Right is the coin name of the operand, prefixed by its namespace if any.
+	Right string
+}
+
+func (e *MismatchError) Error() string {
+	return fmt.Sprintf("cannot %s %s and %s", e.Op, e.Left, e.Right)
+}
+
+func (e *MismatchError) Unwrap() error {
+	return ErrCoinMismatch
+}
+
+// InsufficientFundsError is the error of Settle when the inputs can't cover the amount and the fee.
+//
+// It wraps ErrInsufficientFunds, so errors.Is(err, ErrInsufficientFunds) holds.
+type InsufficientFundsError struct {
+	// Shortfall is the positive amount missing from the inputs.
+	Shortfall Value
+}
+
+func (e *InsufficientFundsError) Error() string {
+	return fmt.Sprintf("%s: short of %s", ErrInsufficientFunds, e.Shortfall)
+}
+
+func (e *InsufficientFundsError) Unwrap() error {
+	return ErrInsufficientFunds
+}
+
+// NewMismatchError returns the *MismatchError of op combining left and right, named by their coin names
+// prefixed by their namespace if any.
+func NewMismatchError(op string, left, right Value) *MismatchError {
+	return &MismatchError{Op: op, Left: qualifiedName(left), Right: qualifiedName(right)}
+}
+
+// qualifiedName returns the coin name of v, prefixed by its namespace if any.
+func qualifiedName(v Value) string {
+	if ns := namespaceOf(v); ns != "" {
+		return ns + "/" + v.CoinName()
+	}
+	return v.CoinName()
+}
+
+// IsNil checks if v is nil, or a nil pointer wrapped in the interface, e.g. a nil *CoinValue.
+//
+// A Value holding a nil pointer isn't equal to nil, but calling its methods dereferences the pointer,
+// so checks for missing Values should use IsNil rather than comparing with nil.
+func IsNil(v Value) bool {
+	if v == nil {
+		return true
+	}
+	rv := reflect.ValueOf(v)
+	return rv.Kind() == reflect.Pointer && rv.IsNil()
+}
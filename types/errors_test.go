@@ -0,0 +1,36 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+)
+
+func TestMismatchError(t *testing.T) {
+	_, err := units(1).SubClamp(NewCoinValue[layer2Definition](big.NewInt(1)))
+
+	for name, err := range map[string]error{"returned": err, "wrapped": fmt.Errorf("settle: %w", err)} {
+		t.Run(name, func(t *testing.T) {
+			var mismatch *MismatchError
+			if !errors.As(err, &mismatch) {
+				t.Fatalf("errors.As(%v, *MismatchError) = false", err)
+			}
+			if mismatch.Op != "subtract" || mismatch.Left != "TST" || mismatch.Right != "layer2/TST" {
+				t.Errorf("MismatchError = %+v, want subtract TST and layer2/TST", *mismatch)
+			}
+			if !errors.Is(err, ErrCoinMismatch) {
+				t.Errorf("errors.Is(%v, ErrCoinMismatch) = false", err)
+			}
+		})
+	}
+
+	defer func() {
+		err, _ := recover().(error)
+		var mismatch *MismatchError
+		if !errors.As(err, &mismatch) || !errors.Is(err, ErrCoinMismatch) {
+			t.Errorf("Add() panicked with %v, want a *MismatchError", err)
+		}
+	}()
+	units(1).Add(NewCoinValue[otherDefinition](big.NewInt(1)))
+}
@@ -0,0 +1,104 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NegativeStyle selects how negative values are rendered.
+type NegativeStyle int
+
+const (
+	// NegativeSign renders negative values with a leading minus sign, e.g. "-1.5 ETH".
+	NegativeSign NegativeStyle = iota
+	// NegativeParens renders negative values in accounting style, e.g. "(1.5 ETH)".
+	NegativeParens
+)
+
+// String returns the value of the CoinValue in whole coins followed by the coin name, e.g. "1.5 ETH".
+//
+// Negative values are rendered with the sign before the number, e.g. "-1.5 ETH".
+func (v CoinValue[D]) String() string {
+	return v.Display(NegativeSign)
+}
+
+// Display returns the value of the CoinValue in whole coins followed by the coin name,
+// rendering negative values according to the given style.
+//
+// Parameters:
+// - style: how to render negative values.
+//
+// Returns:
+// - string: the formatted value.
+func (v CoinValue[D]) Display(style NegativeStyle) string {
+	if style == NegativeParens && v.Units().Sign() < 0 {
+		return "(" + v.Coins().Neg().String() + " " + v.CoinName() + ")"
+	}
+	return v.Coins().String() + " " + v.CoinName()
+}
+
+// FormatOptions configures how CoinValue.Text renders a value. The separators are used verbatim, choosing
+// them for a locale is up to the caller.
+type FormatOptions struct {
+	// Places is the number of decimal places, rounding half away from zero. A negative value keeps every
+	// significant digit.
+	Places int32
+	// ThousandsSep is inserted between groups of three integer digits, e.g. "," for "1,234.56". Empty disables
+	// grouping.
+	ThousandsSep string
+	// DecimalPoint separates the integer and fractional digits. Empty defaults to ".".
+	DecimalPoint string
+	// Unit appends the coin name, e.g. "1,234.56 ETH".
+	Unit bool
+}
+
+// Text returns the value of the CoinValue in whole coins formatted according to the given options,
+// e.g. "1,234.56 ETH" or, with the separators swapped, "1.234,56 ETH".
+//
+// Parameters:
+// - opts: the formatting options.
+//
+// Returns:
+// - string: the formatted value.
+func (v CoinValue[D]) Text(opts FormatOptions) string {
+	coins := v.Coins()
+	var s string
+	if opts.Places < 0 {
+		s = coins.String()
+	} else {
+		s = coins.StringFixed(opts.Places)
+	}
+
+	sign := ""
+	if strings.HasPrefix(s, "-") {
+		sign, s = "-", s[1:]
+	}
+	intPart, fracPart, hasFrac := strings.Cut(s, ".")
+
+	var b strings.Builder
+	b.WriteString(sign)
+	for i, digit := range intPart {
+		if i > 0 && (len(intPart)-i)%3 == 0 {
+			b.WriteString(opts.ThousandsSep)
+		}
+		b.WriteRune(digit)
+	}
+	if hasFrac {
+		if opts.DecimalPoint == "" {
+			b.WriteString(".")
+		} else {
+			b.WriteString(opts.DecimalPoint)
+		}
+		b.WriteString(fracPart)
+	}
+	if opts.Unit {
+		b.WriteString(" " + v.CoinName())
+	}
+	return b.String()
+}
+
+// GoString implements fmt.GoStringer, so that %#v prints the coin and the units rather than the internals
+// of the CoinValue, e.g. "CoinValue[ETH]{units: 1500000000000000000}".
+func (v CoinValue[D]) GoString() string {
+	return fmt.Sprintf("CoinValue[%s]{units: %s}", qualifiedName(v), v.Units())
+}
@@ -0,0 +1,58 @@
+package types
+
+import "testing"
+
+func TestDisplay(t *testing.T) {
+	tests := []struct {
+		name   string
+		value  *CoinValue[testDefinition]
+		sign   string
+		parens string
+	}{
+		{"zero", units(0), "0 TST", "0 TST"},
+		{"one unit below zero", units(-1), "-0.000000000000000001 TST", "(0.000000000000000001 TST)"},
+		{"negative", MustParseCoinValue[testDefinition]("-1.5"), "-1.5 TST", "(1.5 TST)"},
+		{"positive", MustParseCoinValue[testDefinition]("1.5"), "1.5 TST", "1.5 TST"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.value.String(); got != tt.sign {
+				t.Errorf("String() = %q, want %q", got, tt.sign)
+			}
+			if got := tt.value.Display(NegativeSign); got != tt.sign {
+				t.Errorf("Display(NegativeSign) = %q, want %q", got, tt.sign)
+			}
+			if got := tt.value.Display(NegativeParens); got != tt.parens {
+				t.Errorf("Display(NegativeParens) = %q, want %q", got, tt.parens)
+			}
+		})
+	}
+}
+
+func TestText(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		opts  FormatOptions
+		want  string
+	}{
+		{"every digit", "1234.56", FormatOptions{Places: -1}, "1234.56"},
+		{"grouped with unit", "1234.56", FormatOptions{Places: 2, ThousandsSep: ",", Unit: true}, "1,234.56 TST"},
+		{"swapped separators", "1234.56", FormatOptions{Places: 2, ThousandsSep: ".", DecimalPoint: ",", Unit: true}, "1.234,56 TST"},
+		{"several groups", "1234567.891", FormatOptions{Places: 2, ThousandsSep: ","}, "1,234,567.89"},
+		{"no leading separator", "123456", FormatOptions{Places: -1, ThousandsSep: ","}, "123,456"},
+		{"short integer part", "100", FormatOptions{Places: -1, ThousandsSep: ","}, "100"},
+		{"negative", "-1234.5", FormatOptions{Places: 2, ThousandsSep: ","}, "-1,234.50"},
+		{"half rounds up", "0.005", FormatOptions{Places: 2}, "0.01"},
+		{"negative half rounds down", "-0.005", FormatOptions{Places: 2}, "-0.01"},
+		{"zero places", "1234.5", FormatOptions{Places: 0, ThousandsSep: " "}, "1 235"},
+		{"smallest unit", "0.000000000000000001", FormatOptions{Places: -1, DecimalPoint: ","}, "0,000000000000000001"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MustParseCoinValue[testDefinition](tt.value).Text(tt.opts); got != tt.want {
+				t.Errorf("Text(%+v) of %s = %q, want %q", tt.opts, tt.value, got, tt.want)
+			}
+		})
+	}
+}
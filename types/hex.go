@@ -0,0 +1,53 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// ParseHexUnits parses a 0x prefixed hex amount of units, e.g. "0x1bc16d674ec80000".
+//
+// Unlike a strict JSON-RPC quantity, the amount may have leading zeros and an even or odd number of digits,
+// so that both quantities ("0x0", "0x1") and fixed size data words ("0x00…01") are accepted.
+//
+// Parameters:
+// - s: the hex amount.
+//
+// Returns:
+// - *big.Int: the amount of units.
+// - error: an error if s isn't 0x prefixed or has no or invalid hex digits.
+func ParseHexUnits(s string) (*big.Int, error) {
+	digits, ok := strings.CutPrefix(s, "0x")
+	if !ok {
+		digits, ok = strings.CutPrefix(s, "0X")
+	}
+	if !ok {
+		return nil, fmt.Errorf("hex amount %q is missing the 0x prefix", s)
+	}
+	if digits == "" {
+		return nil, errors.New("empty hex amount")
+	}
+	if strings.ContainsAny(digits, "+-") {
+		return nil, fmt.Errorf("invalid hex amount %q", s)
+	}
+
+	units, ok := new(big.Int).SetString(digits, 16)
+	if !ok {
+		return nil, fmt.Errorf("invalid hex amount %q", s)
+	}
+	return units, nil
+}
+
+// ToHex returns the units of the CoinValue as a minimal 0x prefixed hex quantity,
+// as expected by Ethereum JSON-RPC, e.g. "0x1bc16d674ec80000", or "0x0" for zero.
+//
+// Negative values have no valid quantity encoding and are not supported,
+// they are encoded with a leading minus sign, e.g. "-0x1", which ParseHexUnits rejects.
+func (v CoinValue[D]) ToHex() string {
+	if v.Units().Sign() < 0 {
+		return "-0x" + new(big.Int).Neg(v.Units()).Text(16)
+	}
+	return "0x" + v.Units().Text(16)
+}
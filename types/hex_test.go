@@ -0,0 +1,33 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestToHex(t *testing.T) {
+	tests := []struct {
+		units int64
+		want  string
+	}{
+		{2_000_000_000_000_000_000, "0x1bc16d674ec80000"},
+		{0, "0x0"},
+		{1, "0x1"},
+		{1024, "0x400"},
+		{-1, "-0x1"},
+	}
+	for _, tt := range tests {
+		if got := units(tt.units).ToHex(); got != tt.want {
+			t.Errorf("ToHex() of %d units = %q, want %q", tt.units, got, tt.want)
+		}
+	}
+
+	max := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+	hex := NewCoinValue[testDefinition](max).ToHex()
+	if got, err := ParseHexUnits(hex); err != nil || got.Cmp(max) != 0 {
+		t.Errorf("ParseHexUnits(ToHex()) of 2^256-1 = %v, %v", got, err)
+	}
+	if _, err := ParseHexUnits(units(-1).ToHex()); err == nil {
+		t.Errorf("ParseHexUnits() accepted the negative ToHex() encoding")
+	}
+}
@@ -0,0 +1,84 @@
+package types
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+// humanizeDigits is the number of significant digits kept by Humanize, beyond the integer digits.
+const humanizeDigits = 6
+
+// humanizeMin is the smallest amount of a denomination Humanize displays before falling back to a smaller one.
+var humanizeMin = decimal.New(1, -3)
+
+// Denomination is a named unit of a coin, e.g. gwei for Ether.
+type Denomination struct {
+	// Name is the name displayed after amounts, e.g. "gwei".
+	Name string
+	// Exp is the power of 10 of units in one of the denomination, e.g. 9 for gwei.
+	Exp int32
+}
+
+// DenominatedDefinition is an optional extension of ValueDefinition.
+//
+// Definitions implementing it list the denominations of their coin, e.g. wei, gwei and ether,
+// so that values can be displayed in the most readable one. See CoinValue.Humanize.
+type DenominatedDefinition interface {
+	ValueDefinition
+
+	// returns the denominations of the coin, from the smallest to the largest
+	Denominations() []Denomination
+}
+
+// Denominations returns the denominations of the coin, from the smallest to the largest, e.g. to list them in a UI.
+//
+// Definitions that don't implement DenominatedDefinition have a single denomination, whole coins.
+func (v CoinValue[D]) Denominations() []Denomination {
+	if def, ok := any(v.def).(DenominatedDefinition); ok {
+		return def.Denominations()
+	}
+	return []Denomination{{Name: v.CoinName(), Exp: v.def.UnitExp()}}
+}
+
+// In returns the value of the CoinValue in the given denomination, e.g. in gwei for Ether, see ScaledValue.
+//
+// The denomination needn't be one of Denominations, any exponent is accepted.
+func (v CoinValue[D]) In(denom Denomination) decimal.Decimal {
+	return v.ScaledValue(denom.Exp)
+}
+
+// Humanize returns the value of the CoinValue in its most readable denomination, e.g. "500 wei", "3 gwei" or "1.5 ETH".
+//
+// The largest denomination in which the amount is at least 0.001 is used, falling back to the smallest one,
+// and the amount is rounded to 6 significant digits, integer digits always being kept.
+// Definitions that don't implement DenominatedDefinition have a single denomination, whole coins.
+func (v CoinValue[D]) Humanize() string {
+	denominations := v.Denominations()
+
+	units := decimal.NewFromBigInt(v.Units(), 0)
+	denom := denominations[0]
+	for _, d := range denominations[1:] {
+		if units.Abs().Shift(-d.Exp).GreaterThanOrEqual(humanizeMin) {
+			denom = d
+		}
+	}
+
+	amount := units.Shift(-denom.Exp)
+	return roundSignificant(amount, humanizeDigits).String() + " " + denom.Name
+}
+
+// roundSignificant rounds d to the given number of significant digits, keeping all of its integer digits.
+func roundSignificant(d decimal.Decimal, digits int32) decimal.Decimal {
+	if d.IsZero() {
+		return d
+	}
+
+	// the position of the first significant digit relative to the decimal point, e.g. 1 for 1.5 and -2 for 0.005
+	magnitude := int32(d.Abs().Floor().NumDigits())
+	if d.Abs().LessThan(decimal.New(1, 0)) {
+		magnitude = 0
+		for d.Abs().Shift(-magnitude).LessThan(decimal.New(1, -1)) {
+			magnitude--
+		}
+	}
+	return d.Round(max(digits-magnitude, 0))
+}
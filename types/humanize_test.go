@@ -0,0 +1,57 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+)
+
+// denominatedDefinition is the test coin with wei-like denominations.
+type denominatedDefinition struct{ testDefinition }
+
+func (denominatedDefinition) Denominations() []Denomination {
+	return []Denomination{{Name: "wei", Exp: 0}, {Name: "gwei", Exp: 9}, {Name: "TST", Exp: 18}}
+}
+
+func TestHumanize(t *testing.T) {
+	tests := []struct {
+		units string
+		want  string
+	}{
+		{"0", "0 wei"},
+		{"500", "500 wei"},
+		{"3000000000", "3 gwei"},
+		{"1234567891", "1.23457 gwei"},
+		{"999000000000000", "999000 gwei"},
+		{"1000000000000000", "0.001 TST"},
+		{"1500000000000000000", "1.5 TST"},
+		{"-1500000000000000000", "-1.5 TST"},
+		{"123456789123000000000000000", "123456789 TST"},
+	}
+	for _, tt := range tests {
+		units, _ := new(big.Int).SetString(tt.units, 10)
+		if got := NewCoinValue[denominatedDefinition](units).Humanize(); got != tt.want {
+			t.Errorf("Humanize() of %s units = %q, want %q", tt.units, got, tt.want)
+		}
+	}
+
+	// a single denomination without DenominatedDefinition
+	if got := units(1).Humanize(); got != "0.000000000000000001 TST" {
+		t.Errorf("Humanize() of 1 unit = %q, want 0.000000000000000001 TST", got)
+	}
+	if got := units(1_234_567_000_000_000_000).Humanize(); got != "1.23457 TST" {
+		t.Errorf("Humanize() = %q, want 1.23457 TST", got)
+	}
+}
+
+func TestIn(t *testing.T) {
+	v := NewCoinValue[denominatedDefinition](big.NewInt(1_500_000_000))
+	for _, d := range v.Denominations() {
+		want := map[string]string{"wei": "1500000000", "gwei": "1.5", "TST": "0.0000000015"}[d.Name]
+		if got := v.In(d); got.String() != want {
+			t.Errorf("In(%s) = %s, want %s", d.Name, got, want)
+		}
+	}
+	if got := units(1).Denominations(); len(got) != 1 || got[0] != (Denomination{Name: "TST", Exp: 18}) {
+		t.Errorf("Denominations() = %v, want whole coins only", got)
+	}
+}
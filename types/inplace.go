@@ -0,0 +1,61 @@
+package types
+
+import "math/big"
+
+// The methods of this file mutate their receiver, unlike the rest of CoinValue, to accumulate
+// values in hot loops without allocating a new CoinValue and big.Int on every operation.
+//
+// They break the value semantics of CoinValue: copies of a CoinValue share its units, and so do
+// the big.Int returned by Units and the values returned by some methods, e.g. Clamp.
+// Only mutate a CoinValue exclusively owned by the caller, such as one returned by Clone,
+// and keep the immutable methods for anything else.
+
+// Clone returns a deep copy of the CoinValue, which can safely be mutated in place.
+func (v CoinValue[D]) Clone() *CoinValue[D] {
+	return v.derive(new(big.Int).Set(v.Units()))
+}
+
+// AddInPlace adds the value of another Value to the CoinValue, modifying it.
+//
+// It panics like Add if the coins differ or other is nil. See the warning of Clone.
+//
+// Parameters:
+// - other: the Value to add.
+func (v *CoinValue[D]) AddInPlace(other Value) {
+	if err := v.check("add", other); err != nil {
+		panic(err)
+	}
+	v.mutable().Add(v.value, other.Units())
+}
+
+// SubInPlace subtracts the value of another Value from the CoinValue, modifying it.
+//
+// It panics like Sub if the coins differ or other is nil. See the warning of Clone.
+//
+// Parameters:
+// - other: the Value to subtract.
+func (v *CoinValue[D]) SubInPlace(other Value) {
+	if err := v.check("subtract", other); err != nil {
+		panic(err)
+	}
+	v.mutable().Sub(v.value, other.Units())
+}
+
+// MulScalarInPlace multiplies the CoinValue by a scalar, modifying it. See the warning of Clone.
+//
+// Parameters:
+// - scalar: the scalar to multiply with.
+func (v *CoinValue[D]) MulScalarInPlace(scalar *big.Int) {
+	v.mutable().Mul(v.value, scalar)
+}
+
+// mutable returns the units of the CoinValue for an in-place update, invalidating the memoized coins.
+func (v *CoinValue[D]) mutable() *big.Int {
+	if v.value == nil {
+		v.value = new(big.Int)
+	}
+	if v.coins != nil {
+		v.coins.coins.Store(nil)
+	}
+	return v.value
+}
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestInPlace(t *testing.T) {
+	original := units(1000)
+	v := original.Clone()
+	coins := v.Coins()
+
+	v.AddInPlace(units(500))
+	v.SubInPlace(units(300))
+	v.MulScalarInPlace(big.NewInt(3))
+
+	if want := big.NewInt(3600); v.Units().Cmp(want) != 0 {
+		t.Errorf("Units() = %s, want %s", v.Units(), want)
+	}
+	if original.Units().Int64() != 1000 {
+		t.Errorf("the original of the clone was modified, %s units", original.Units())
+	}
+	if v.Coins().Equal(coins) {
+		t.Errorf("Coins() = %s, want the memoized coins to be invalidated", v.Coins())
+	}
+	if want := units(3600).Coins(); !v.Coins().Equal(want) {
+		t.Errorf("Coins() = %s, want %s", v.Coins(), want)
+	}
+
+	var zero CoinValue[testDefinition]
+	zero.AddInPlace(units(1))
+	if zero.Units().Int64() != 1 {
+		t.Errorf("AddInPlace() on the zero CoinValue = %s units, want 1", zero.Units())
+	}
+}
+
+// values are the values accumulated by the in-place benchmarks, of the deltas of the AddUnits benchmarks.
+var values = func() []Value {
+	v := make([]Value, len(deltas))
+	for i, d := range deltas {
+		v[i] = NewCoinValue[testDefinition](d)
+	}
+	return v
+}()
+
+func BenchmarkAccumulateAddValues(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var sum Value = units(0)
+		for _, v := range values {
+			sum = sum.Add(v)
+		}
+	}
+}
+
+func BenchmarkAccumulateAddInPlace(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		sum := units(0)
+		for _, v := range values {
+			sum.AddInPlace(v)
+		}
+	}
+}
+
+func BenchmarkCompoundMulScalar(b *testing.B) {
+	factor := big.NewInt(3)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var v Value = units(1)
+		for range 64 {
+			v = v.MulScalar(factor)
+		}
+	}
+}
+
+func BenchmarkCompoundMulScalarInPlace(b *testing.B) {
+	factor := big.NewInt(3)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		v := units(1)
+		for range 64 {
+			v.MulScalarInPlace(factor)
+		}
+	}
+}
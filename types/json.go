@@ -0,0 +1,52 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+)
+
+// UnitsOnly is a CoinValue encoded in JSON as its units only, e.g. "1000", without the coin name,
+// for fields whose coin is implied by their type, e.g. a fee field of type UnitsOnly of the Ether definition.
+//
+// The units are encoded as a string, so that JSON consumers decoding numbers into float64 don't lose precision.
+type UnitsOnly[D ValueDefinition] struct {
+	CoinValue[D]
+}
+
+// MarshalJSON implements json.Marshaler, encoding the units as a JSON string.
+func (u UnitsOnly[D]) MarshalJSON() ([]byte, error) {
+	b := make([]byte, 0, u.Units().BitLen()/3+3)
+	b = append(b, '"')
+	b = u.AppendJSON(b)
+	return append(b, '"'), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+//
+// The units may be encoded as a string or as a number, but must be an integer.
+// The decoded value is validated, see CoinValue.Validate. As usual, null leaves the value unchanged.
+func (u *UnitsOnly[D]) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if s == "null" {
+		return nil
+	}
+	if unquoted, err := strconv.Unquote(s); err == nil {
+		s = unquoted
+	} else if !json.Valid(data) {
+		return fmt.Errorf("invalid JSON units %s", data)
+	}
+
+	units, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return fmt.Errorf("invalid %s units %s", u.CoinName(), data)
+	}
+
+	cv := NewCoinValue[D](units)
+	if err := cv.Validate(); err != nil {
+		return err
+	}
+	u.CoinValue = *cv
+	return nil
+}
@@ -0,0 +1,116 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+)
+
+// cappedDefinition bounds its units to [0, 1000].
+type cappedDefinition struct{ testDefinition }
+
+func (cappedDefinition) MaxUnits() *big.Int { return big.NewInt(1000) }
+
+func TestUnitsOnlyRoundTrip(t *testing.T) {
+	type payload struct {
+		Fee UnitsOnly[testDefinition] `json:"fee"`
+	}
+
+	maxUint256, _ := new(big.Int).SetString("115792089237316195423570985008687907853269984665640564039457584007913129639935", 10)
+	tests := []struct {
+		name  string
+		value *CoinValue[testDefinition]
+		want  string
+	}{
+		{"zero", units(0), `{"fee":"0"}`},
+		{"negative", units(-1000), `{"fee":"-1000"}`},
+		{"78 digits", NewCoinValue[testDefinition](maxUint256), `{"fee":"` + maxUint256.String() + `"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(payload{Fee: UnitsOnly[testDefinition]{*tt.value}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(data) != tt.want {
+				t.Errorf("Marshal() = %s, want %s", data, tt.want)
+			}
+
+			var got payload
+			if err := json.Unmarshal(data, &got); err != nil {
+				t.Fatal(err)
+			}
+			if !Equal(&got.Fee.CoinValue, tt.value) {
+				t.Errorf("Unmarshal() = %s, want %s", &got.Fee.CoinValue, tt.value)
+			}
+		})
+	}
+}
+
+func TestUnitsOnlyUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want int64
+	}{
+		{"string", `"1000"`, 1000},
+		{"number", `1000`, 1000},
+		{"negative number", `-5`, -5},
+		{"null leaves the value unchanged", `null`, 7},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := UnitsOnly[testDefinition]{*units(7)}
+			if err := u.UnmarshalJSON([]byte(tt.data)); err != nil {
+				t.Fatal(err)
+			}
+			if got := u.Units().Int64(); got != tt.want {
+				t.Errorf("UnmarshalJSON(%s) = %d units, want %d", tt.data, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnitsOnlyUnmarshalJSONInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"decimal string", `"1.5"`},
+		{"decimal number", `1.5`},
+		{"exponent", `1e3`},
+		{"hex", `"0x10"`},
+		{"empty string", `""`},
+		{"word", `"abc"`},
+		{"boolean", `true`},
+		{"object", `{"units":"1"}`},
+		{"unterminated string", `"1000`},
+		{"invalid JSON", `10 00`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := UnitsOnly[testDefinition]{*units(7)}
+			if err := u.UnmarshalJSON([]byte(tt.data)); err == nil {
+				t.Errorf("UnmarshalJSON(%s) = %s, want an error", tt.data, u.Units())
+			}
+			if got := u.Units().Int64(); got != 7 {
+				t.Errorf("UnmarshalJSON(%s) changed the value to %d units", tt.data, got)
+			}
+		})
+	}
+}
+
+func TestUnitsOnlyUnmarshalJSONOutOfRange(t *testing.T) {
+	for _, data := range []string{`"-1"`, `"1001"`} {
+		var u UnitsOnly[cappedDefinition]
+		if err := u.UnmarshalJSON([]byte(data)); !errors.Is(err, ErrOutOfRange) {
+			t.Errorf("UnmarshalJSON(%s) error = %v, want ErrOutOfRange", data, err)
+		}
+	}
+
+	var u UnitsOnly[cappedDefinition]
+	if err := u.UnmarshalJSON([]byte(`"1000"`)); err != nil {
+		t.Errorf("UnmarshalJSON(\"1000\") error = %v", err)
+	}
+}
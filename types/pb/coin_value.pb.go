@@ -0,0 +1,172 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        (unknown)
+// source: types/pb/coin_value.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// CoinValue is an amount of a coin, in the smallest unit of the coin.
+//
+// Backward compatibility: field numbers must never be reused or renumbered.
+// New fields may be added with new numbers, readers ignore the fields they don't know,
+// and a missing field decodes as its zero value, i.e. a value without units is zero.
+type CoinValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the coin name, e.g. "ETH"
+	Coin string `protobuf:"bytes,1,opt,name=coin,proto3" json:"coin,omitempty"`
+	// the magnitude of the amount in units, big-endian without leading zeros, empty for zero
+	Units []byte `protobuf:"bytes,2,opt,name=units,proto3" json:"units,omitempty"`
+	// whether the amount is negative, never set for zero
+	Negative bool `protobuf:"varint,3,opt,name=negative,proto3" json:"negative,omitempty"`
+}
+
+func (x *CoinValue) Reset() {
+	*x = CoinValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_pb_coin_value_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CoinValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoinValue) ProtoMessage() {}
+
+func (x *CoinValue) ProtoReflect() protoreflect.Message {
+	mi := &file_types_pb_coin_value_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoinValue.ProtoReflect.Descriptor instead.
+func (*CoinValue) Descriptor() ([]byte, []int) {
+	return file_types_pb_coin_value_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CoinValue) GetCoin() string {
+	if x != nil {
+		return x.Coin
+	}
+	return ""
+}
+
+func (x *CoinValue) GetUnits() []byte {
+	if x != nil {
+		return x.Units
+	}
+	return nil
+}
+
+func (x *CoinValue) GetNegative() bool {
+	if x != nil {
+		return x.Negative
+	}
+	return false
+}
+
+var File_types_pb_coin_value_proto protoreflect.FileDescriptor
+
+var file_types_pb_coin_value_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x70, 0x62, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x6c, 0x69, 0x62,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x22, 0x51, 0x0a, 0x09,
+	0x43, 0x6f, 0x69, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x69,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x69, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x75, 0x6e,
+	0x69, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x65, 0x67, 0x61, 0x74, 0x69, 0x76, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6e, 0x65, 0x67, 0x61, 0x74, 0x69, 0x76, 0x65, 0x42,
+	0x29, 0x5a, 0x27, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x69,
+	0x72, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x2f, 0x6c, 0x69, 0x62, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x6f, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_types_pb_coin_value_proto_rawDescOnce sync.Once
+	file_types_pb_coin_value_proto_rawDescData = file_types_pb_coin_value_proto_rawDesc
+)
+
+func file_types_pb_coin_value_proto_rawDescGZIP() []byte {
+	file_types_pb_coin_value_proto_rawDescOnce.Do(func() {
+		file_types_pb_coin_value_proto_rawDescData = protoimpl.X.CompressGZIP(file_types_pb_coin_value_proto_rawDescData)
+	})
+	return file_types_pb_coin_value_proto_rawDescData
+}
+
+var file_types_pb_coin_value_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_types_pb_coin_value_proto_goTypes = []interface{}{
+	(*CoinValue)(nil), // 0: libcrypto.types.CoinValue
+}
+var file_types_pb_coin_value_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_types_pb_coin_value_proto_init() }
+func file_types_pb_coin_value_proto_init() {
+	if File_types_pb_coin_value_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_types_pb_coin_value_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CoinValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_types_pb_coin_value_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_types_pb_coin_value_proto_goTypes,
+		DependencyIndexes: file_types_pb_coin_value_proto_depIdxs,
+		MessageInfos:      file_types_pb_coin_value_proto_msgTypes,
+	}.Build()
+	File_types_pb_coin_value_proto = out.File
+	file_types_pb_coin_value_proto_rawDesc = nil
+	file_types_pb_coin_value_proto_goTypes = nil
+	file_types_pb_coin_value_proto_depIdxs = nil
+}
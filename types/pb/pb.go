@@ -0,0 +1,12 @@
+// Package pb provides the protobuf representation of coin values, see coin_value.proto.
+//
+// CoinValue is generated with protoc-gen-go, so it is a proto.Message that services can marshal with
+// proto.Marshal and embed in their own messages by importing "types/pb/coin_value.proto".
+package pb
+
+import "errors"
+
+//go:generate protoc -I ../.. --go_out=../.. --go_opt=paths=source_relative types/pb/coin_value.proto
+
+// ErrNilMessage is returned when decoding a nil message.
+var ErrNilMessage = errors.New("nil message")
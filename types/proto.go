@@ -0,0 +1,68 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/airsigner/libcrypto/types/pb"
+)
+
+// ToProto returns the protobuf representation of the CoinValue.
+//
+// The coin is encoded by its qualified name, see Register, and the magnitude in big-endian
+// with a separate sign flag, so that no string parsing is involved.
+func (v CoinValue[D]) ToProto() *pb.CoinValue {
+	return &pb.CoinValue{
+		Coin:     qualifiedName(v),
+		Units:    v.Units().Bytes(),
+		Negative: v.Units().Sign() < 0,
+	}
+}
+
+// FromProto sets the CoinValue to the value of its protobuf representation.
+//
+// Parameters:
+// - m: the protobuf representation, as returned by ToProto.
+//
+// Returns:
+// - error: an error if m is nil, or ErrCoinMismatch if m isn't of the coin of the CoinValue.
+//
+// Chain types embedding a *CoinValue must implement it themselves with DecodeInto, see there.
+func (v *CoinValue[D]) FromProto(m *pb.CoinValue) error {
+	if v == nil {
+		return fmt.Errorf("%w: cannot decode into a nil CoinValue", ErrNilValue)
+	}
+	if m == nil {
+		return pb.ErrNilMessage
+	}
+	if name := qualifiedName(CoinValue[D]{}); m.GetCoin() != name {
+		return fmt.Errorf("%w: cannot decode %s into %s", ErrCoinMismatch, m.GetCoin(), name)
+	}
+
+	v.value = unitsFromProto(m)
+	v.coins = new(coinsMemo)
+	return nil
+}
+
+// FromProto creates a value of the coin of the protobuf representation, which must be registered, see Register.
+//
+// Parameters:
+// - m: the protobuf representation.
+//
+// Returns:
+// - Value: the new value.
+// - error: an error if m is nil, or ErrUnknownCoin if the coin isn't registered.
+func FromProto(m *pb.CoinValue) (Value, error) {
+	if m == nil {
+		return nil, pb.ErrNilMessage
+	}
+	return NewByName(m.GetCoin(), unitsFromProto(m))
+}
+
+func unitsFromProto(m *pb.CoinValue) *big.Int {
+	units := new(big.Int).SetBytes(m.GetUnits())
+	if m.GetNegative() {
+		units.Neg(units)
+	}
+	return units
+}
@@ -0,0 +1,101 @@
+package types
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/airsigner/libcrypto/types/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+func init() {
+	Register(testDefinition{}.CoinName(), func(units *big.Int) Value {
+		return NewCoinValue[testDefinition](units)
+	})
+}
+
+func TestProtoRoundTrip(t *testing.T) {
+	huge, _ := new(big.Int).SetString("-123456789012345678901234567890123456789012345678901234567890", 10)
+	for _, want := range []*big.Int{big.NewInt(0), big.NewInt(1), big.NewInt(-1), big.NewInt(1e18), huge} {
+		m := NewCoinValue[testDefinition](want).ToProto()
+		if want.Sign() == 0 && (len(m.GetUnits()) != 0 || m.GetNegative()) {
+			t.Errorf("ToProto() of zero = %v, want no units and no sign", m)
+		}
+
+		data, err := proto.Marshal(m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		decoded := new(pb.CoinValue)
+		if err := proto.Unmarshal(data, decoded); err != nil {
+			t.Fatal(err)
+		}
+
+		var got CoinValue[testDefinition]
+		if err := got.FromProto(decoded); err != nil {
+			t.Fatalf("FromProto() of %s: %v", want, err)
+		}
+		if got.Units().Cmp(want) != 0 {
+			t.Errorf("round trip of %s = %s", want, got.Units())
+		}
+
+		v, err := FromProto(decoded)
+		if err != nil {
+			t.Fatalf("dynamic FromProto() of %s: %v", want, err)
+		}
+		if !Equal(v, NewCoinValue[testDefinition](want)) {
+			t.Errorf("dynamic round trip of %s = %s %s", want, v.Units(), v.CoinName())
+		}
+	}
+}
+
+func TestProtoWireFormat(t *testing.T) {
+	// coin = "TST", units = 0x0100, negative = true
+	want := []byte{0x0a, 3, 'T', 'S', 'T', 0x12, 2, 0x01, 0x00, 0x18, 1}
+	data, err := proto.Marshal(units(-256).ToProto())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != string(want) {
+		t.Errorf("proto.Marshal() = %x, want %x", data, want)
+	}
+}
+
+func TestFromProtoErrors(t *testing.T) {
+	var v CoinValue[testDefinition]
+	if err := v.FromProto(nil); !errors.Is(err, pb.ErrNilMessage) {
+		t.Errorf("FromProto(nil) = %v, want ErrNilMessage", err)
+	}
+	if err := v.FromProto(&pb.CoinValue{Coin: "ETH"}); !errors.Is(err, ErrCoinMismatch) {
+		t.Errorf("FromProto() of an ETH value = %v, want ErrCoinMismatch", err)
+	}
+	if _, err := FromProto(nil); !errors.Is(err, pb.ErrNilMessage) {
+		t.Errorf("dynamic FromProto(nil) = %v, want ErrNilMessage", err)
+	}
+	if _, err := FromProto(&pb.CoinValue{Coin: "NOPE"}); !errors.Is(err, ErrUnknownCoin) {
+		t.Errorf("dynamic FromProto() of an unknown coin = %v, want ErrUnknownCoin", err)
+	}
+}
+
+func TestFromProtoNilReceiver(t *testing.T) {
+	var v *CoinValue[testDefinition]
+	if err := v.FromProto(units(1).ToProto()); !errors.Is(err, ErrNilValue) {
+		t.Errorf("FromProto() into nil = %v, want ErrNilValue", err)
+	}
+}
+
+func TestProtoNamespaced(t *testing.T) {
+	m := NewCoinValue[layer2Definition](big.NewInt(1)).ToProto()
+	if m.GetCoin() != "layer2/TST" {
+		t.Errorf("ToProto() coin = %q, want layer2/TST", m.GetCoin())
+	}
+
+	var v CoinValue[testDefinition]
+	if err := v.FromProto(m); !errors.Is(err, ErrCoinMismatch) {
+		t.Errorf("FromProto() of another namespace = %v, want ErrCoinMismatch", err)
+	}
+	if got, err := FromProto(m); err != nil || namespaceOf(got) != "layer2" {
+		t.Errorf("FromProto() = %v, %v, want a layer2 value", got, err)
+	}
+}
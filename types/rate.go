@@ -0,0 +1,86 @@
+package types
+
+import (
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// SecondsPerYear is the number of seconds of the 365 days year used by APRToPerSecond.
+const SecondsPerYear = 365 * 24 * 60 * 60
+
+// perSecondPrecision is the number of decimal places of the rates returned by APRToPerSecond.
+const perSecondPrecision = 36
+
+// Rate is a flow of value over time, e.g. 1 ETH per hour, as used by streaming payments.
+type Rate struct {
+	amount Value
+	per    time.Duration
+}
+
+// NewRate creates a Rate of amount per the given duration.
+//
+// Parameters:
+// - amount: the Value flowing during the duration.
+// - per: the duration, which must be positive.
+//
+// Returns:
+// - *Rate: the new Rate.
+// - error: an error if the duration isn't positive.
+func NewRate(amount Value, per time.Duration) (*Rate, error) {
+	if per <= 0 {
+		return nil, errors.New("rate duration must be positive")
+	}
+
+	return &Rate{
+		amount: amount,
+		per:    per,
+	}, nil
+}
+
+// AmountOver returns the amount accrued by the Rate over the given duration.
+//
+// The computation is done in units with arbitrary precision, so long durations can't overflow,
+// and the result is truncated toward zero to the unit, e.g. a rate of -10 units per 3 seconds
+// accrues -3 units over a second.
+//
+// Parameters:
+// - d: the duration to accrue over.
+//
+// Returns:
+// - Value: the accrued amount.
+func (r *Rate) AmountOver(d time.Duration) Value {
+	elapsed, per := big.NewInt(int64(d)), big.NewInt(int64(r.per))
+	if r.amount.Units().Sign()*elapsed.Sign() >= 0 {
+		return r.amount.MulScalar(elapsed).DivScalar(per)
+	}
+	// DivScalar floors, so the negative accrual is truncated by flooring its opposite
+	return r.amount.MulScalar(elapsed.Neg(elapsed)).DivScalar(per).MulScalar(big.NewInt(-1))
+}
+
+// PerSecond returns the amount accrued by the Rate every second, truncated toward zero to the unit.
+func (r *Rate) PerSecond() Value {
+	return r.AmountOver(time.Second)
+}
+
+// APRToPerSecond converts an annual percentage rate to the simple interest rate per second, see CoinValue.Accrue,
+// e.g. 0.05 for 5% APR, over a 365 days year.
+//
+// The division rarely is exact, so the rate is rounded up at 36 decimal places: accruing over a whole year
+// then yields the interest at the APR rather than falling a unit short, the excess being negligible.
+//
+// Parameters:
+// - apr: the annual rate, e.g. 0.05 for 5%.
+//
+// Returns:
+// - decimal.Decimal: the rate per second.
+func APRToPerSecond(apr decimal.Decimal) decimal.Decimal {
+	seconds := decimal.NewFromInt(SecondsPerYear)
+	rate := apr.DivRound(seconds, perSecondPrecision)
+	if rate.Mul(seconds).LessThan(apr) {
+		rate = rate.Add(decimal.New(1, -perSecondPrecision))
+	}
+	return rate
+}
@@ -0,0 +1,102 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestRateAmountOver(t *testing.T) {
+	oneEth := NewCoinValueFromCoins[testDefinition](decimal.NewFromInt(1))
+	rate, err := NewRate(oneEth, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := rate.AmountOver(30*time.Minute), NewCoinValueFromCoins[testDefinition](decimal.RequireFromString("0.5")); !Equal(got, want) {
+		t.Errorf("AmountOver(30m) = %s, want %s", got, want)
+	}
+	// 1e18 / 3600 = 277777777777777.77...
+	if got, want := rate.PerSecond(), units(277777777777777); !Equal(got, want) {
+		t.Errorf("PerSecond() = %s units, want %s units", got.Units(), want.Units())
+	}
+	// 100 years of nanoseconds times 1e18 overflows 64 bits
+	if got, want := rate.AmountOver(100*365*24*time.Hour), NewCoinValueFromCoins[testDefinition](decimal.NewFromInt(876000)); !Equal(got, want) {
+		t.Errorf("AmountOver(100y) = %s, want %s", got, want)
+	}
+}
+
+func TestRateAmountOverTruncatesTowardZero(t *testing.T) {
+	tests := []struct {
+		amount int64
+		d      time.Duration
+		want   int64
+	}{
+		{10, time.Second, 3},
+		{-10, time.Second, -3},
+		{10, -time.Second, -3},
+		{-10, -time.Second, 3},
+		{-9, time.Second, -3},
+		{-10, 0, 0},
+	}
+	for _, tt := range tests {
+		rate, err := NewRate(units(tt.amount), 3*time.Second)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := rate.AmountOver(tt.d); got.Units().Int64() != tt.want {
+			t.Errorf("rate of %d units per 3s: AmountOver(%s) = %s units, want %d", tt.amount, tt.d, got.Units(), tt.want)
+		}
+	}
+
+	rate, err := NewRate(units(-10), 3*time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := rate.PerSecond(); got.Units().Int64() != -3 {
+		t.Errorf("PerSecond() of -10 units per 3s = %s units, want -3", got.Units())
+	}
+}
+
+func TestAccrue(t *testing.T) {
+	tests := []struct {
+		units   int64
+		rate    string
+		seconds int64
+		want    int64
+	}{
+		{100, "0.1", 1, 110},
+		// simple interest, unlike Compound's 121
+		{100, "0.1", 2, 120},
+		{100, "0.1", 0, 100},
+		{100, "0", 1000, 100},
+		// 103.3 rounded down
+		{100, "0.011", 3, 103},
+		// -103.3 rounded down
+		{-100, "0.011", 3, -104},
+	}
+	for _, tt := range tests {
+		got := units(tt.units).Accrue(decimal.RequireFromString(tt.rate), tt.seconds)
+		if got.Units().Int64() != tt.want {
+			t.Errorf("Accrue(%s, %d) of %d units = %s, want %d", tt.rate, tt.seconds, tt.units, got.Units(), tt.want)
+		}
+	}
+
+	oneCoin := NewCoinValueFromCoins[testDefinition](decimal.NewFromInt(1))
+	got := oneCoin.Accrue(APRToPerSecond(decimal.RequireFromString("0.05")), SecondsPerYear)
+	if want := NewCoinValueFromCoins[testDefinition](decimal.RequireFromString("1.05")); !Equal(got, want) {
+		t.Errorf("Accrue(5%% APR, 1y) of 1 TST = %s, want %s", got, want)
+	}
+	if oneCoin.Units().Cmp(big.NewInt(1e18)) != 0 {
+		t.Errorf("Accrue() modified the receiver to %s units", oneCoin.Units())
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Accrue() over negative seconds did not panic")
+		}
+	}()
+	units(100).Accrue(decimal.RequireFromString("0.1"), -1)
+}
@@ -0,0 +1,79 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+)
+
+// ErrUnknownCoin is returned when no constructor is registered for a coin.
+var ErrUnknownCoin = errors.New("unknown coin")
+
+var (
+	registryMu sync.RWMutex
+	registry   = make(map[string]func(units *big.Int) Value)
+)
+
+// Register makes a value constructor available by coin, for coins only known at runtime.
+//
+// Coins are identified by their qualified name: the coin name, prefixed by the namespace and a slash
+// for coins of a NamespacedDefinition, e.g. "ETH" or "ethereum-arbitrum/ETH", so that same-named coins
+// of different namespaces can both be registered.
+//
+// Chain packages register their coins in init, so importing a chain package is enough to make its coin available.
+// Register panics if ctor is nil, if it doesn't create values of the named coin,
+// or if a constructor is already registered for the name.
+//
+// Parameters:
+// - name: the qualified name of the coin.
+// - ctor: the constructor creating a value of the coin from an amount of units.
+func Register(name string, ctor func(units *big.Int) Value) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	if ctor == nil {
+		panic("types: Register constructor is nil")
+	}
+	if got := qualifiedName(ctor(new(big.Int))); got != name {
+		panic("types: Register constructor for coin " + name + " creates values of coin " + got)
+	}
+	if _, dup := registry[name]; dup {
+		panic("types: Register called twice for coin " + name)
+	}
+	registry[name] = ctor
+}
+
+// NewByName creates a value of the coin registered under the given name.
+//
+// Parameters:
+// - name: the qualified name of the coin, see Register.
+// - units: the amount in units.
+//
+// Returns:
+// - Value: the new value.
+// - error: ErrUnknownCoin if no coin is registered under the name.
+func NewByName(name string, units *big.Int) (Value, error) {
+	registryMu.RLock()
+	ctor, ok := registry[name]
+	registryMu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknownCoin, name)
+	}
+	return ctor(units), nil
+}
+
+// Registered returns the sorted qualified names of the registered coins.
+func Registered() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,76 @@
+package types
+
+import (
+	"errors"
+	"math/big"
+	"slices"
+	"testing"
+)
+
+func TestNewByName(t *testing.T) {
+	v, err := NewByName("TST", big.NewInt(1_500_000_000_000_000_000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := v.(*CoinValue[testDefinition]); !ok {
+		t.Fatalf("NewByName(TST) = %T, want *CoinValue[testDefinition]", v)
+	}
+	if v.CoinName() != "TST" || v.Coins().String() != "1.5" {
+		t.Errorf("NewByName(TST) = %s %s, want 1.5 TST", v.Coins(), v.CoinName())
+	}
+
+	if _, err := NewByName("UNKNOWN", big.NewInt(1)); !errors.Is(err, ErrUnknownCoin) {
+		t.Errorf("NewByName(UNKNOWN) error = %v, want ErrUnknownCoin", err)
+	}
+}
+
+func TestRegistered(t *testing.T) {
+	names := Registered()
+	if !slices.IsSorted(names) {
+		t.Errorf("Registered() = %v, not sorted", names)
+	}
+	for _, name := range []string{"TST", "layer2/TST"} {
+		if !slices.Contains(names, name) {
+			t.Errorf("Registered() = %v, missing %s", names, name)
+		}
+	}
+}
+
+func TestRegisterPanics(t *testing.T) {
+	tests := []struct {
+		name     string
+		coinName string
+		ctor     func(units *big.Int) Value
+	}{
+		{"duplicate name", "TST", func(units *big.Int) Value { return NewCoinValue[testDefinition](units) }},
+		{"nil constructor", "OTH", nil},
+		{"unqualified name of a namespaced coin", "TST", func(units *big.Int) Value { return NewCoinValue[layer2Definition](units) }},
+		{"name of another coin", "OTH", func(units *big.Int) Value { return NewCoinValue[testDefinition](units) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Register(%s) didn't panic", tt.coinName)
+				}
+			}()
+			Register(tt.coinName, tt.ctor)
+		})
+	}
+
+	if _, err := NewByName("OTH", big.NewInt(1)); !errors.Is(err, ErrUnknownCoin) {
+		t.Errorf("a failed Register() registered the coin: NewByName(OTH) error = %v", err)
+	}
+}
+
+func TestNewByNameNamespaced(t *testing.T) {
+	for name, want := range map[string]string{"TST": "", "layer2/TST": "layer2"} {
+		v, err := NewByName(name, big.NewInt(1))
+		if err != nil {
+			t.Fatalf("NewByName(%q) error = %v", name, err)
+		}
+		if ns := namespaceOf(v); ns != want {
+			t.Errorf("NewByName(%q) namespace = %q, want %q", name, ns, want)
+		}
+	}
+}
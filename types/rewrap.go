@@ -0,0 +1,41 @@
+package types
+
+import "math/big"
+
+// Rewrap converts a Value into a chain type through one of its unit constructors,
+// checking that the Value is of the coin of the chain type.
+//
+// Arithmetic methods return a Value, losing the chain type they were called on;
+// Rewrap reconstitutes it without the caller checking the coin by hand, e.g. for Eth:
+//
+//	total, err := types.Rewrap(a.Add(b), eth.NewEthFromWei)
+//	if err != nil {
+//		return err
+//	}
+//	fmt.Println(total.GWei())
+//
+// Parameters:
+// - v: the Value to convert.
+// - ctor: the constructor of the chain type from an amount of units, e.g. eth.NewEthFromWei.
+//
+// Returns:
+// - T: the chain type holding the units of v.
+// - error: a *MismatchError if v isn't of the coin of the chain type.
+func Rewrap[T Value](v Value, ctor func(units *big.Int) T) (T, error) {
+	t := ctor(new(big.Int).Set(v.Units()))
+	if !t.Same(v) {
+		var zero T
+		return zero, NewMismatchError("convert", v, t)
+	}
+	return t, nil
+}
+
+// MustRewrap is like Rewrap but panics if v isn't of the coin of the chain type,
+// for values known to be of the coin, e.g. the sum of two values of the chain type.
+func MustRewrap[T Value](v Value, ctor func(units *big.Int) T) T {
+	t, err := Rewrap(v, ctor)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
@@ -0,0 +1,40 @@
+package types
+
+import "fmt"
+
+// Settle returns the change left when paying an amount and a fee out of inputs, i.e. inputs - (amount + fee),
+// e.g. the change output of a UTXO transaction.
+//
+// Parameters:
+// - inputs: the funds available.
+// - amount: the non-negative amount to pay.
+// - fee: the non-negative fee to pay.
+//
+// Returns:
+// - Value: the non-negative change.
+// - error: an error wrapping ErrNilValue if a value is nil, a *MismatchError if the values aren't all of
+// the same coin, ErrOutOfRange if the amount or the fee is negative, or an *InsufficientFundsError holding
+// the shortfall if the inputs can't cover the amount and the fee.
+func Settle(inputs, amount, fee Value) (change Value, err error) {
+	if IsNil(inputs) || IsNil(amount) || IsNil(fee) {
+		return nil, fmt.Errorf("%w: cannot settle a nil value", ErrNilValue)
+	}
+	if !amount.Same(inputs) {
+		return nil, NewMismatchError("settle", inputs, amount)
+	}
+	if !fee.Same(inputs) {
+		return nil, NewMismatchError("settle", inputs, fee)
+	}
+	if amount.Units().Sign() < 0 {
+		return nil, fmt.Errorf("%w: negative amount %s", ErrOutOfRange, amount)
+	}
+	if fee.Units().Sign() < 0 {
+		return nil, fmt.Errorf("%w: negative fee %s", ErrOutOfRange, fee)
+	}
+
+	total := amount.Add(fee)
+	if inputs.Units().Cmp(total.Units()) < 0 {
+		return nil, &InsufficientFundsError{Shortfall: total.Sub(inputs)}
+	}
+	return inputs.Sub(total), nil
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestWithSlippage(t *testing.T) {
+	hundred := NewCoinValueFromCoins[testDefinition](decimal.NewFromInt(100))
+
+	if got, want := hundred.WithSlippageDown(50), MustParseCoinValue[testDefinition]("99.5"); !Equal(got, want) {
+		t.Errorf("WithSlippageDown(50) = %s, want %s", got, want)
+	}
+	if got, want := hundred.WithSlippageUp(50), MustParseCoinValue[testDefinition]("100.5"); !Equal(got, want) {
+		t.Errorf("WithSlippageUp(50) = %s, want %s", got, want)
+	}
+	if got := hundred.WithSlippageDown(10_000); got.Units().Sign() != 0 {
+		t.Errorf("WithSlippageDown(10000) = %s, want zero", got)
+	}
+	if got := hundred.WithSlippageUp(0); !Equal(got, hundred) {
+		t.Errorf("WithSlippageUp(0) = %s, want %s", got, hundred)
+	}
+
+	// conservative rounding: 999 units * 0.995 = 994.005, 999 units * 1.005 = 1003.995
+	if got := units(999).WithSlippageDown(50); got.Units().Int64() != 994 {
+		t.Errorf("WithSlippageDown(50) of 999 units = %s units, want 994", got.Units())
+	}
+	if got := units(999).WithSlippageUp(50); got.Units().Int64() != 1004 {
+		t.Errorf("WithSlippageUp(50) of 999 units = %s units, want 1004", got.Units())
+	}
+}
+
+func TestWithSlippageRejectsOutOfRangeBps(t *testing.T) {
+	hundred := NewCoinValueFromCoins[testDefinition](decimal.NewFromInt(100))
+	tests := []struct {
+		name string
+		f    func(bps int) Value
+		bps  int
+	}{
+		{"down negative", hundred.WithSlippageDown, -1},
+		{"down above 100%", hundred.WithSlippageDown, 10_001},
+		{"up negative", hundred.WithSlippageUp, -50},
+		{"up above 100%", hundred.WithSlippageUp, 20_000},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				err, _ := recover().(error)
+				if !errors.Is(err, ErrOutOfRange) {
+					t.Errorf("panic = %v, want ErrOutOfRange", err)
+				}
+			}()
+			got := tt.f(tt.bps)
+			t.Errorf("slippage of %d bps = %s, want a panic", tt.bps, got)
+		})
+	}
+}
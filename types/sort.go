@@ -0,0 +1,73 @@
+package types
+
+import (
+	"fmt"
+	"slices"
+)
+
+// SortAscending sorts values of the same coin in place, from the smallest to the largest.
+//
+// The sort is stable, equal values keep their order. SortAscending panics if the values
+// aren't all of the same coin, before modifying the slice; use TrySortAscending to get an error instead.
+func SortAscending(values []Value) {
+	if err := TrySortAscending(values); err != nil {
+		panic(err)
+	}
+}
+
+// SortDescending sorts values of the same coin in place, from the largest to the smallest, see SortAscending.
+func SortDescending(values []Value) {
+	if err := TrySortDescending(values); err != nil {
+		panic(err)
+	}
+}
+
+// TrySortAscending is like SortAscending but returns an error instead of panicking.
+//
+// Returns:
+// - error: a *MismatchError if the values aren't all of the same coin, or an error wrapping ErrNilValue
+// if a value is nil, in which case values is left unchanged.
+func TrySortAscending(values []Value) error {
+	if err := checkSameCoin("sort", values); err != nil {
+		return err
+	}
+	slices.SortStableFunc(values, cmpUnits)
+	return nil
+}
+
+// TrySortDescending is like SortDescending but returns an error instead of panicking, see TrySortAscending.
+func TrySortDescending(values []Value) error {
+	if err := checkSameCoin("sort", values); err != nil {
+		return err
+	}
+	slices.SortStableFunc(values, func(a, b Value) int { return cmpUnits(b, a) })
+	return nil
+}
+
+// IsSorted checks if values of the same coin are sorted in ascending order.
+//
+// Like Cmp, it panics if the values aren't all of the same coin.
+func IsSorted(values []Value) bool {
+	if err := checkSameCoin("compare", values); err != nil {
+		panic(err)
+	}
+	return slices.IsSortedFunc(values, cmpUnits)
+}
+
+// cmpUnits compares the units of values already checked to be of the same coin.
+func cmpUnits(a, b Value) int {
+	return a.Units().Cmp(b.Units())
+}
+
+// checkSameCoin returns an error if a value is nil or if the values aren't all of the same coin.
+func checkSameCoin(op string, values []Value) error {
+	for i, value := range values {
+		if IsNil(value) {
+			return fmt.Errorf("%w: cannot %s nil value at index %d", ErrNilValue, op, i)
+		}
+		if !values[0].Same(value) {
+			return NewMismatchError(op, values[0], value)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package types
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func TestSortStable(t *testing.T) {
+	// equal values are told apart by identity
+	first, second, third := units(5), units(5), units(5)
+	values := []Value{units(9), first, units(-1), second, units(7), third}
+
+	SortAscending(values)
+	want := []int64{-1, 5, 5, 5, 7, 9}
+	for i, v := range values {
+		if v.Units().Int64() != want[i] {
+			t.Fatalf("SortAscending() = %v, want units %v", values, want)
+		}
+	}
+	if values[1] != Value(first) || values[2] != Value(second) || values[3] != Value(third) {
+		t.Errorf("SortAscending() reordered equal values")
+	}
+	if !IsSorted(values) {
+		t.Errorf("IsSorted() = false after SortAscending()")
+	}
+
+	SortDescending(values)
+	want = []int64{9, 7, 5, 5, 5, -1}
+	for i, v := range values {
+		if v.Units().Int64() != want[i] {
+			t.Fatalf("SortDescending() = %v, want units %v", values, want)
+		}
+	}
+	if values[2] != Value(first) || values[3] != Value(second) || values[4] != Value(third) {
+		t.Errorf("SortDescending() reordered equal values")
+	}
+	if IsSorted(values) {
+		t.Errorf("IsSorted() = true after SortDescending()")
+	}
+}
+
+func TestSortCoinMismatch(t *testing.T) {
+	other := NewCoinValue[otherDefinition](big.NewInt(1))
+	values := []Value{units(2), other, units(1)}
+
+	var mismatch *MismatchError
+	if err := TrySortAscending(values); !errors.As(err, &mismatch) || !errors.Is(err, ErrCoinMismatch) {
+		t.Errorf("TrySortAscending() = %v, want a *MismatchError", err)
+	}
+	if err := TrySortDescending(values); !errors.As(err, &mismatch) {
+		t.Errorf("TrySortDescending() = %v, want a *MismatchError", err)
+	}
+	if values[0].Units().Int64() != 2 || values[1] != Value(other) {
+		t.Errorf("a failed sort modified the values: %v", values)
+	}
+	if err := TrySortAscending([]Value{units(1), (*CoinValue[testDefinition])(nil)}); !errors.Is(err, ErrNilValue) {
+		t.Errorf("TrySortAscending() with a nil value = %v, want ErrNilValue", err)
+	}
+
+	defer func() {
+		if err, _ := recover().(error); !errors.As(err, &mismatch) {
+			t.Errorf("SortAscending() panicked with %v, want a *MismatchError", err)
+		}
+	}()
+	SortAscending(values)
+}
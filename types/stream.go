@@ -0,0 +1,72 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+)
+
+// jsonValue is the JSON form of a value of any registered coin, the units being a string or a number.
+type jsonValue struct {
+	Coin  string      `json:"coin"`
+	Units json.Number `json:"units"`
+}
+
+// DecodeValues decodes a JSON array of values of registered coins, e.g. [{"coin": "ETH", "units": "1000"}],
+// the coins being identified by their qualified name, see Register,
+// calling yield for each value as soon as it is decoded, so that large arrays aren't held in memory.
+//
+// The units may be encoded as strings or as numbers, but must be integers.
+// Decoding stops at the first error, including the first error returned by yield.
+//
+// Parameters:
+// - r: the reader of the JSON array.
+// - yield: the function called with each value.
+//
+// Returns:
+// - error: the error of yield as is, or an error if the stream isn't a valid array of values,
+// wrapping ErrUnknownCoin if a coin isn't registered.
+func DecodeValues(r io.Reader, yield func(Value) error) error {
+	dec := json.NewDecoder(r)
+	if err := expectDelim(dec, '['); err != nil {
+		return err
+	}
+
+	for i := 0; dec.More(); i++ {
+		var jv jsonValue
+		if err := dec.Decode(&jv); err != nil {
+			return fmt.Errorf("value %d: %w", i, err)
+		}
+
+		units, ok := new(big.Int).SetString(jv.Units.String(), 10)
+		if !ok {
+			return fmt.Errorf("value %d: invalid units %q", i, jv.Units)
+		}
+		value, err := NewByName(jv.Coin, units)
+		if err != nil {
+			return fmt.Errorf("value %d: %w", i, err)
+		}
+
+		if err := yield(value); err != nil {
+			return err
+		}
+	}
+	return expectDelim(dec, ']')
+}
+
+// expectDelim reads the next token of dec, failing if it isn't the given delimiter.
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+	tok, err := dec.Token()
+	if errors.Is(err, io.EOF) {
+		return io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return err
+	}
+	if tok != delim {
+		return fmt.Errorf("expected %s, got %v", delim, tok)
+	}
+	return nil
+}
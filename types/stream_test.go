@@ -0,0 +1,79 @@
+package types
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestDecodeValues(t *testing.T) {
+	stream := `[{"coin": "TST", "units": "1000"}, {"coin": "layer2/TST", "units": 2}, {"coin": "TST", "units": "-3"}]`
+
+	var got []Value
+	err := DecodeValues(strings.NewReader(stream), func(v Value) error {
+		got = append(got, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Value{units(1000), NewCoinValue[layer2Definition](units(2).Units()), units(-3)}
+	if len(got) != len(want) {
+		t.Fatalf("DecodeValues() yielded %d values, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if !Equal(got[i], want[i]) {
+			t.Errorf("value %d = %s, want %s", i, got[i], want[i])
+		}
+	}
+}
+
+func TestDecodeValuesTruncated(t *testing.T) {
+	tests := []struct {
+		name   string
+		stream string
+		yields int
+	}{
+		{"empty", ``, 0},
+		{"before the first value", `[`, 0},
+		{"inside a value", `[{"coin": "TST", "units": "1000"}, {"coin": "TS`, 1},
+		{"before the end", `[{"coin": "TST", "units": "1000"}`, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			yields := 0
+			err := DecodeValues(strings.NewReader(tt.stream), func(Value) error {
+				yields++
+				return nil
+			})
+			if err == nil {
+				t.Errorf("DecodeValues() of a truncated stream succeeded")
+			}
+			if yields != tt.yields {
+				t.Errorf("DecodeValues() yielded %d values before failing, want %d", yields, tt.yields)
+			}
+		})
+	}
+}
+
+func TestDecodeValuesErrors(t *testing.T) {
+	stop := errors.New("stop")
+	tests := []struct {
+		name    string
+		stream  string
+		wantErr error
+	}{
+		{"unknown coin", `[{"coin": "UNKNOWN", "units": "1"}]`, ErrUnknownCoin},
+		{"yield error", `[{"coin": "TST", "units": "1"}]`, stop},
+		{"fractional units", `[{"coin": "TST", "units": 1.5}]`, nil},
+		{"not an array", `{"coin": "TST", "units": "1"}`, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := DecodeValues(strings.NewReader(tt.stream), func(Value) error { return stop })
+			if err == nil || (tt.wantErr != nil && !errors.Is(err, tt.wantErr)) {
+				t.Errorf("DecodeValues() = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -1,7 +1,11 @@
 package types
 
 import (
+	"errors"
+	"fmt"
+	"math"
 	"math/big"
+	"sync/atomic"
 
 	"github.com/shopspring/decimal"
 )
@@ -24,6 +28,17 @@ type Value interface {
 	DivScalar(scalar *big.Int) Value
 }
 
+// Comparable is an optional extension of Value, for values that can be ordered against values of the same coin.
+//
+// It is kept out of Value so that existing implementations of Value keep compiling; check for it with a type assertion.
+// CoinValue implements it.
+type Comparable interface {
+	Value
+
+	// compares the value with another value of the same coin, returning -1, 0 or +1
+	Cmp(other Value) int
+}
+
 type ValueDefinition interface {
 	// returns the name of the coin
 	// To be used to determine if we are talking about the same coin
@@ -34,52 +49,337 @@ type ValueDefinition interface {
 	UnitExp() int32
 }
 
+// NamespacedDefinition is an optional extension of ValueDefinition.
+//
+// Definitions implementing it scope their coin name to a namespace (e.g. "ethereum-mainnet"),
+// so that two coins sharing a name on different networks are not considered the same.
+// Definitions that don't implement it have the empty namespace.
+type NamespacedDefinition interface {
+	ValueDefinition
+
+	// returns the namespace the coin lives in
+	Namespace() string
+}
+
+// BoundedDefinition is an optional extension of ValueDefinition.
+//
+// Definitions implementing it have a known maximum supply, e.g. 21 million coins for Bitcoin,
+// any value above it signaling a bug. See CoinValue.Validate.
+type BoundedDefinition interface {
+	ValueDefinition
+
+	// returns the maximum number of units that can exist
+	MaxUnits() *big.Int
+}
+
+// CoinValue is an amount of the coin defined by D, held in its smallest unit.
+//
+// CoinValue is immutable: all its methods use value receivers and return new values,
+// so that both CoinValue[D] and *CoinValue[D] implement Value and can be stored either way.
+// The only exceptions are the decoding methods, e.g. UnmarshalBinary, which set the receiver,
+// and the in-place arithmetic methods, e.g. AddInPlace, meant for performance-critical accumulation.
+//
+// The arithmetic methods, e.g. Add or Sub, make two allocations: the resulting CoinValue and the words of its units.
 type CoinValue[D ValueDefinition] struct {
 	def   D
 	value *big.Int
+
+	// coins memoizes Coins(), CoinValue being immutable once constructed except for the in-place methods,
+	// which reset it
+	coins *coinsMemo
+}
+
+type coinsMemo struct {
+	coins atomic.Pointer[decimal.Decimal]
 }
 
+// both forms of CoinValue implement Value
+var (
+	_ Value = CoinValue[ValueDefinition]{}
+	_ Value = (*CoinValue[ValueDefinition])(nil)
+)
+
+// NewCoinValue creates a CoinValue from an amount of units, nil being zero.
+//
+// The CoinValue takes ownership of value, which must not be modified afterwards.
 func NewCoinValue[D ValueDefinition](value *big.Int) *CoinValue[D] {
-	return &CoinValue[D]{
-		value: func() *big.Int {
-			if value == nil {
-				return big.NewInt(0)
-			}
-			return value
-		}(),
+	if value == nil {
+		value = big.NewInt(0)
 	}
+	return CoinValue[D]{}.derive(value)
 }
 
+// NewNonNegativeCoinValue is like NewCoinValue but fails for negative units, e.g. to guard a balance read
+// from storage.
+//
+// Parameters:
+// - units: the amount of units, nil being zero.
+//
+// Returns:
+// - *CoinValue[D]: the new CoinValue.
+// - error: ErrOutOfRange if units is negative.
+func NewNonNegativeCoinValue[D ValueDefinition](units *big.Int) (*CoinValue[D], error) {
+	if units != nil && units.Sign() < 0 {
+		var def D
+		return nil, fmt.Errorf("%w: %s %s units is negative", ErrOutOfRange, units, def.CoinName())
+	}
+	return NewCoinValue[D](units), nil
+}
+
+// coinValueBlock holds a CoinValue along with its memo, so that both are allocated at once.
+type coinValueBlock[D ValueDefinition] struct {
+	cv   CoinValue[D]
+	memo coinsMemo
+}
+
+// unitsBlock holds a CoinValue along with its units and memo, so that all three are allocated at once.
+type unitsBlock[D ValueDefinition] struct {
+	cv    CoinValue[D]
+	units big.Int
+	memo  coinsMemo
+}
+
+// derive creates a new CoinValue with the same definition and the given value.
+func (v CoinValue[D]) derive(value *big.Int) *CoinValue[D] {
+	b := &coinValueBlock[D]{}
+	b.cv = CoinValue[D]{def: v.def, value: value, coins: &b.memo}
+	return &b.cv
+}
+
+// deriveUnits creates a new zero CoinValue with the same definition, returning it along with its units to be set.
+//
+// It is the allocation of the arithmetic hot path: the CoinValue, its units and its memo are a single allocation,
+// so that e.g. Add only allocates that block and the words of the units.
+func (v CoinValue[D]) deriveUnits() (*CoinValue[D], *big.Int) {
+	b := &unitsBlock[D]{}
+	b.cv = CoinValue[D]{def: v.def, value: &b.units, coins: &b.memo}
+	return &b.cv, &b.units
+}
+
+// NewCoinValueFromCoins creates a CoinValue from an amount of whole coins.
+//
+// Any fraction of the amount below one unit is silently truncated toward zero,
+// use NewCoinValueFromCoinsChecked to reject such amounts instead.
+// For an amount with at most UnitExp fractional digits the conversion is exact,
+// and Coins() on the result is guaranteed to be equal to the amount.
 func NewCoinValueFromCoins[D ValueDefinition](value decimal.Decimal) *CoinValue[D] {
 	cv := NewCoinValue[D](nil)
 	cv.value = value.Mul(decimal.New(1, cv.def.UnitExp())).BigInt()
 	return cv
 }
 
-func NewCoinValueFromScaled[D ValueDefinition](value decimal.Decimal, exp int32) *CoinValue[D] {
+// NewCoinValueFromCoinsChecked creates a CoinValue from an amount of whole coins,
+// failing instead of truncating when the amount has a fraction below one unit.
+//
+// Parameters:
+// - value: the amount in whole coins.
+//
+// Returns:
+// - *CoinValue[D]: the new CoinValue.
+// - error: an error if the amount has more than UnitExp fractional digits.
+func NewCoinValueFromCoinsChecked[D ValueDefinition](value decimal.Decimal) (*CoinValue[D], error) {
 	cv := NewCoinValue[D](nil)
-	cv.value = value.Mul(decimal.New(1, cv.def.UnitExp()-exp)).BigInt()
+	units := value.Shift(cv.def.UnitExp())
+	if !units.IsInteger() {
+		return nil, fmt.Errorf("%s %s has more than %d decimals", value, cv.def.CoinName(), cv.def.UnitExp())
+	}
+	cv.value = units.BigInt()
+	return cv, nil
+}
+
+// ParseCoinValue creates a CoinValue from a decimal string amount of whole coins, e.g. "1.5".
+//
+// Parameters:
+// - s: the amount in whole coins.
+//
+// Returns:
+// - *CoinValue[D]: the new CoinValue.
+// - error: an error if s isn't a decimal number or has more than UnitExp fractional digits,
+// or ErrOutOfRange if its exponent is beyond 1000, e.g. "1e1001".
+func ParseCoinValue[D ValueDefinition](s string) (*CoinValue[D], error) {
+	var def D
+	value, err := decimal.NewFromString(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s amount %q: %w", def.CoinName(), s, err)
+	}
+	if exp := value.Exponent(); exp > maxParseExponent || exp < -maxParseExponent {
+		return nil, fmt.Errorf("%w: %s amount %q has an exponent beyond %d", ErrOutOfRange, def.CoinName(), s, maxParseExponent)
+	}
+	return NewCoinValueFromCoinsChecked[D](value)
+}
+
+// maxParseExponent bounds the exponent of the amounts accepted by ParseCoinValue, e.g. "1e100000",
+// whose conversion to units would take a time growing with the exponent.
+const maxParseExponent = 1000
+
+// MustParseCoinValue is like ParseCoinValue but panics if s can't be parsed.
+//
+// It is intended for tests and constants, where the amount is known to be valid.
+func MustParseCoinValue[D ValueDefinition](s string) *CoinValue[D] {
+	cv, err := ParseCoinValue[D](s)
+	if err != nil {
+		panic(err)
+	}
 	return cv
 }
 
+// NewCoinValueFromValue creates a CoinValue from a Value of the same coin,
+// e.g. to recover a chain type from the result of an arithmetic operation.
+//
+// Parameters:
+// - value: the Value to convert.
+//
+// Returns:
+// - *CoinValue[D]: the new CoinValue.
+// - error: a *MismatchError if the Value is of a different coin.
+func NewCoinValueFromValue[D ValueDefinition](value Value) (*CoinValue[D], error) {
+	var zero CoinValue[D]
+	if err := zero.check("convert", value); err != nil {
+		return nil, err
+	}
+	return NewCoinValue[D](new(big.Int).Set(value.Units())), nil
+}
+
+// NewCoinValueFromScaled creates a CoinValue from an amount in units of 10^exp of the smallest unit,
+// e.g. 9 for GWei amounts of Ether, the inverse of ScaledValue.
+//
+// Any fraction of the amount below one unit is silently truncated toward zero,
+// use NewCoinValueFromScaledChecked to reject such amounts instead.
+//
+// Breaking change: exp used to be counted from whole coins, the amount being multiplied by 10^(UnitExp-exp).
+// It is now counted from the smallest unit, the amount being multiplied by 10^exp, so that it matches ScaledValue.
+// For Ether, KWei amounts used to be created with exp 15 and are now created with exp 3;
+// callers of the former semantics must pass UnitExp-exp instead of exp.
+func NewCoinValueFromScaled[D ValueDefinition](value decimal.Decimal, exp int32) *CoinValue[D] {
+	return NewCoinValue[D](value.Shift(exp).BigInt())
+}
+
+// NewCoinValueFromScaledChecked creates a CoinValue from an amount scaled by the given exponent,
+// failing instead of truncating when the amount has a fraction below one unit.
+//
+// exp is counted from the smallest unit, see the breaking change of NewCoinValueFromScaled.
+//
+// Parameters:
+// - value: the amount, in units of 10^exp of the smallest unit.
+// - exp: the exponent the amount is scaled by.
+//
+// Returns:
+// - *CoinValue[D]: the new CoinValue.
+// - error: an error if the amount has more than exp fractional digits.
+func NewCoinValueFromScaledChecked[D ValueDefinition](value decimal.Decimal, exp int32) (*CoinValue[D], error) {
+	units := value.Shift(exp)
+	if !units.IsInteger() {
+		var def D
+		return nil, fmt.Errorf("%s %s at scale %d has more than %d decimals", value, def.CoinName(), exp, exp)
+	}
+	return NewCoinValue[D](units.BigInt()), nil
+}
+
+// NewCoinValueFromFloat creates a CoinValue from a float64 amount of whole coins.
+//
+// WARNING: float64 only holds about 15 significant decimal digits, so any precision beyond that
+// is lost, and values like 0.1 are not exactly representable to begin with.
+// The float is converted through decimal.NewFromFloat, which yields the shortest decimal
+// that round-trips to the same float, and any fraction below one unit is truncated.
+// Prefer NewCoinValueFromCoins with a decimal parsed from a string whenever possible;
+// this constructor exists for callers that only have a float64 and accept the loss.
+//
+// Parameters:
+// - f: the amount in whole coins.
+//
+// Returns:
+// - *CoinValue[D]: the new CoinValue.
+// - error: an error if f is NaN or infinite.
+func NewCoinValueFromFloat[D ValueDefinition](f float64) (*CoinValue[D], error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return nil, errors.New("cannot create value from NaN or infinite float")
+	}
+
+	return NewCoinValueFromCoins[D](decimal.NewFromFloat(f)), nil
+}
+
 // Units returns the value of the CoinValue in the smallest unit.
 //
 // For example for Ethereum this would return the value denominated in wei.
 //
 // Returns:
-// - *big.Int: the value of the CoinValue in the smallest unit..
+// - *big.Int: the value of the CoinValue in the smallest unit, zero for the zero CoinValue.
 func (v CoinValue[D]) Units() *big.Int {
+	if v.value == nil {
+		return new(big.Int)
+	}
 	return v.value
 }
 
+// BitLen returns the number of bits of the absolute value of the units, 0 for a zero value.
+func (v CoinValue[D]) BitLen() int {
+	return v.Units().BitLen()
+}
+
+// ByteLen returns the number of bytes of the absolute value of the units in big-endian, 0 for a zero value.
+func (v CoinValue[D]) ByteLen() int {
+	return (v.BitLen() + 7) / 8
+}
+
+// FitsInUint256 checks if the units can be encoded as a Solidity uint256, i.e. are not negative and fit in 256 bits.
+//
+// Values that don't fit make PackUint256 fail, check it before building a transaction.
+func (v CoinValue[D]) FitsInUint256() bool {
+	return v.Units().Sign() >= 0 && v.BitLen() <= 256
+}
+
 // Coins returns the value of the CoinValue in whole coin units.
 //
 // For example for Ethereum this would return the value denomitated in Ether.
 //
+// The result is exact, as any number of units has at most UnitExp fractional digits in whole coins,
+// so that for any amount d with at most UnitExp fractional digits, Coins() of NewCoinValueFromCoins(d) equals d.
+//
 // Returns:
 // - decimal.Decimal: The value of the CoinValue in whole coind units.
+//
+// The result is computed once and memoized, so repeated calls are cheap.
 func (v CoinValue[D]) Coins() decimal.Decimal {
-	return decimal.NewFromBigInt(v.value, 0).DivRound(decimal.New(1, v.def.UnitExp()), v.def.UnitExp())
+	if v.coins == nil {
+		return v.computeCoins()
+	}
+	if coins := v.coins.coins.Load(); coins != nil {
+		return *coins
+	}
+
+	coins := v.computeCoins()
+	v.coins.coins.Store(&coins)
+	return coins
+}
+
+func (v CoinValue[D]) computeCoins() decimal.Decimal {
+	return decimal.NewFromBigInt(v.Units(), 0).DivRound(decimal.New(1, v.def.UnitExp()), v.def.UnitExp())
+}
+
+// CoinsWithPrecision returns the value of the CoinValue in whole coin units, rounded to prec fractional digits.
+//
+// Coins uses a precision of UnitExp, which is exact. A lower precision rounds half away from zero,
+// e.g. 1.5 Ether at precision 0 is 2 Ether and -1.5 Ether is -2 Ether, and a negative precision rounds
+// to the left of the decimal point. A higher precision adds no digits, the result is then the exact value.
+//
+// Parameters:
+// - prec: the number of fractional digits of the result.
+//
+// Returns:
+// - decimal.Decimal: the rounded value of the CoinValue in whole coin units.
+func (v CoinValue[D]) CoinsWithPrecision(prec int32) decimal.Decimal {
+	return v.ScaledValueWithPrecision(v.def.UnitExp(), prec)
+}
+
+// ExactCoins returns the value of the CoinValue in whole coin units, without any rounding.
+//
+// The decimal is built directly from the units with an exponent of -UnitExp, so no division is involved,
+// e.g. a single wei is exactly 0.000000000000000001 Ether.
+//
+// Returns:
+// - decimal.Decimal: The value of the CoinValue in whole coin units.
+func (v CoinValue[D]) ExactCoins() decimal.Decimal {
+	return decimal.NewFromBigInt(v.Units(), -v.def.UnitExp())
 }
 
 // ScaledValue returns the value of the CoinValue in decimal form, scaled by the given exponent.
@@ -92,7 +392,118 @@ func (v CoinValue[D]) Coins() decimal.Decimal {
 // Returns:
 // - decimal.Decimal: the scaled value of the CoinValue.
 func (v CoinValue[D]) ScaledValue(exp int32) decimal.Decimal {
-	return decimal.NewFromBigInt(v.value, 0).DivRound(decimal.New(1, exp), v.def.UnitExp())
+	return v.ScaledValueWithPrecision(exp, v.def.UnitExp())
+}
+
+// ScaledValueWithPrecision returns the value of the CoinValue scaled by the given exponent,
+// rounded to prec fractional digits, see CoinsWithPrecision for the rounding.
+//
+// Parameters:
+// - exp: the exponent to scale the value by.
+// - prec: the number of fractional digits of the result, UnitExp for ScaledValue.
+//
+// Returns:
+// - decimal.Decimal: the rounded scaled value of the CoinValue.
+func (v CoinValue[D]) ScaledValueWithPrecision(exp, prec int32) decimal.Decimal {
+	return decimal.NewFromBigInt(v.Units(), 0).DivRound(decimal.New(1, exp), prec)
+}
+
+// Float64 returns the value of the CoinValue scaled by the given exponent as a float64, e.g. for metrics gauges.
+//
+// The amount is the one of ScaledValue, without its rounding, converted to the nearest float64.
+// float64 only holds about 15 significant decimal digits, so large or very precise amounts,
+// such as most wei amounts in Ether, are not exactly representable: the result is then approximate
+// and the exactness flag is false. Never use the result for arithmetic or accounting.
+//
+// Parameters:
+// - exp: the exponent to scale the value by, e.g. 18 for Ether amounts in Ether.
+//
+// Returns:
+// - float64: the scaled value of the CoinValue.
+// - bool: true if the float64 is exactly the scaled value, false if precision was lost.
+func (v CoinValue[D]) Float64(exp int32) (float64, bool) {
+	return decimal.NewFromBigInt(v.Units(), -exp).Float64()
+}
+
+// ScaledUnits returns the value of the CoinValue as an integer count of 10^(UnitExp-exp) units.
+//
+// In other words the result is the value in whole coins with exp decimals, as used by ABI encoded amounts.
+// For example for Ethereum the exponent value 8 would return the value as an 8 decimals integer,
+// 1.23456789 Eth being returned as 123456789.
+//
+// Parameters:
+// - exp: the number of decimals of the result.
+//
+// Returns:
+// - *big.Int: the value expressed with exp decimals.
+// - error: an error if the value can't be expressed exactly with exp decimals.
+func (v CoinValue[D]) ScaledUnits(exp int32) (*big.Int, error) {
+	shift := v.def.UnitExp() - exp
+	if shift <= 0 {
+		return new(big.Int).Mul(v.Units(), pow10(-shift)), nil
+	}
+
+	q, r := new(big.Int).QuoRem(v.Units(), pow10(shift), new(big.Int))
+	if r.Sign() != 0 {
+		return nil, fmt.Errorf("value %s %s cannot be expressed exactly with %d decimals", v.Coins(), v.CoinName(), exp)
+	}
+	return q, nil
+}
+
+// ScaledUnitsTruncate returns the value of the CoinValue as an integer count of 10^(UnitExp-exp) units,
+// like ScaledUnits, but truncates the value toward zero when it can't be expressed exactly.
+//
+// Parameters:
+// - exp: the number of decimals of the result.
+//
+// Returns:
+// - *big.Int: the value expressed with exp decimals.
+func (v CoinValue[D]) ScaledUnitsTruncate(exp int32) *big.Int {
+	shift := v.def.UnitExp() - exp
+	if shift <= 0 {
+		return new(big.Int).Mul(v.Units(), pow10(-shift))
+	}
+	return new(big.Int).Quo(v.Units(), pow10(shift))
+}
+
+// pow10 returns 10^n.
+func pow10(n int32) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// AppendJSON appends the units of the CoinValue to dst as a bare JSON number and returns the extended buffer.
+//
+// The number is written as a plain base 10 integer, never in exponent notation, so the value is exact.
+// Note that many JSON consumers (notably JavaScript's JSON.parse) decode numbers into float64,
+// silently losing precision above 2^53; only emit numbers for consumers using a BigInt-aware parser,
+// and prefer encoding the units as a string otherwise.
+//
+// Parameters:
+// - dst: the buffer to append to.
+//
+// Returns:
+// - []byte: the extended buffer.
+func (v CoinValue[D]) AppendJSON(dst []byte) []byte {
+	return v.Units().Append(dst, 10)
+}
+
+// Validate checks that the CoinValue is within the range allowed by its definition.
+//
+// When the definition implements BoundedDefinition the value must be within [0, MaxUnits];
+// values of definitions without it are always valid.
+//
+// Returns:
+// - error: ErrOutOfRange if the value is outside of the allowed range, nil otherwise.
+func (v CoinValue[D]) Validate() error {
+	def, ok := any(v.def).(BoundedDefinition)
+	if !ok {
+		return nil
+	}
+
+	if v.Units().Sign() < 0 || v.Units().Cmp(def.MaxUnits()) > 0 {
+		return fmt.Errorf("%w: %s is not within [0, %s]", ErrOutOfRange, v.Units(), def.MaxUnits())
+	}
+	return nil
 }
 
 // CoinName returns the name of the coin associated with the CoinValue.
@@ -100,22 +511,202 @@ func (v CoinValue[D]) CoinName() string {
 	return v.def.CoinName()
 }
 
-// Same checks if the CoinValue is the same as another Value by comparing their coin names.
+// Namespace returns the namespace of the coin associated with the CoinValue.
+//
+// It is empty unless the definition implements NamespacedDefinition.
+func (v CoinValue[D]) Namespace() string {
+	if def, ok := any(v.def).(NamespacedDefinition); ok {
+		return def.Namespace()
+	}
+	return ""
+}
+
+// Same checks if the CoinValue is the same as another Value by comparing their coin names and namespaces.
+//
+// The namespace of the other Value is taken from its Namespace method when it has one,
+// and is considered empty otherwise.
 //
 // Parameters:
 // - other: the Value to compare with.
 //
 // Returns:
-// - bool: true if the coin names are the same, false otherwise.
+// - bool: true if the coin names and namespaces are the same, false otherwise or if other is nil.
 func (v CoinValue[D]) Same(other Value) bool {
-	return v.CoinName() == other.CoinName()
+	return !IsNil(other) && v.CoinName() == other.CoinName() && v.Namespace() == namespaceOf(other)
+}
+
+// Equals checks if the CoinValue is equal to another Value, i.e. of the same coin and with the same units.
+//
+// Unlike Same, which only checks that two values are of the same coin regardless of their amounts,
+// Equals compares the amounts too. Values built through different constructors are equal
+// as long as they hold the same units, e.g. 1 Eth and 10^18 wei.
+//
+// Always compare values with Equals or Equal, e.g. in tests, rather than with reflect.DeepEqual:
+// equal big.Int units may have different internal representations, and the memoized coins may differ.
+//
+// Parameters:
+// - other: the Value to compare with.
+//
+// Returns:
+// - bool: true if the coins and the units are the same, false otherwise.
+func (v CoinValue[D]) Equals(other Value) bool {
+	return v.Same(other) && v.Units().Cmp(other.Units()) == 0
+}
+
+// Equal checks if two values are equal, i.e. of the same coin and with the same units, see CoinValue.Equals.
+//
+// It works with any implementation of Value, Equals not being part of the interface.
+// Two nil values are equal, and a nil value is never equal to a non-nil one, see IsNil.
+//
+// Parameters:
+// - a: the first Value.
+// - b: the second Value.
+//
+// Returns:
+// - bool: true if the values are equal, false otherwise.
+func Equal(a, b Value) bool {
+	if IsNil(a) || IsNil(b) {
+		return IsNil(a) && IsNil(b)
+	}
+	return a.Same(b) && a.Units().Cmp(b.Units()) == 0
+}
+
+// namespaceOf returns the namespace of a Value, or the empty namespace if it doesn't expose one.
+func namespaceOf(v Value) string {
+	if ns, ok := v.(interface{ Namespace() string }); ok {
+		return ns.Namespace()
+	}
+	return ""
+}
+
+// check returns an error if other can't be combined with the CoinValue in op:
+// an error wrapping ErrNilValue if other is nil, or a *MismatchError if it is of a different coin.
+func (v CoinValue[D]) check(op string, other Value) error {
+	if IsNil(other) {
+		return fmt.Errorf("%w: cannot %s %s and nil", ErrNilValue, op, qualifiedName(v))
+	}
+	if !v.Same(other) {
+		return NewMismatchError(op, v, other)
+	}
+	return nil
+}
+
+// Cmp compares the CoinValue with another Value of the same coin.
+//
+// The function panics with a *MismatchError if the coins differ, or with an error wrapping ErrNilValue if other is nil.
+//
+// Parameters:
+// - other: the Value to compare with.
+//
+// Returns:
+// - int: -1 if v < other, 0 if v == other and +1 if v > other.
+func (v CoinValue[D]) Cmp(other Value) int {
+	if err := v.check("compare", other); err != nil {
+		panic(err)
+	}
+
+	return v.Units().Cmp(other.Units())
+}
+
+// CmpCoins compares the CoinValue with an amount of whole coins, e.g. a configured fee cap.
+//
+// The amount is converted to units like NewCoinValueFromCoins does, truncating any fraction below one unit,
+// so that the result is the one of Cmp with the value built from the same amount.
+//
+// Parameters:
+// - coins: the amount of whole coins to compare with.
+//
+// Returns:
+// - int: -1 if v < coins, 0 if v == coins and +1 if v > coins.
+func (v CoinValue[D]) CmpCoins(coins decimal.Decimal) int {
+	return v.Units().Cmp(NewCoinValueFromCoins[D](coins).Units())
+}
+
+// ExceedsCoins checks if the CoinValue is strictly above an amount of whole coins, see CmpCoins.
+func (v CoinValue[D]) ExceedsCoins(coins decimal.Decimal) bool {
+	return v.CmpCoins(coins) > 0
+}
+
+// CmpUnits compares the CoinValue with a raw amount of units, e.g. a reserve of 1000 wei.
+//
+// No coin check is performed: units is dimensionless and taken as units of the coin of the CoinValue.
+//
+// Parameters:
+// - units: the amount of units to compare with.
+//
+// Returns:
+// - int: -1 if v < units, 0 if v == units and +1 if v > units.
+func (v CoinValue[D]) CmpUnits(units *big.Int) int {
+	return v.Units().Cmp(units)
+}
+
+// GtUnits checks if the CoinValue is strictly above a raw amount of units, see CmpUnits.
+func (v CoinValue[D]) GtUnits(units *big.Int) bool {
+	return v.CmpUnits(units) > 0
+}
+
+// LtUnits checks if the CoinValue is strictly below a raw amount of units, see CmpUnits.
+func (v CoinValue[D]) LtUnits(units *big.Int) bool {
+	return v.CmpUnits(units) < 0
+}
+
+// IsDust checks if the CoinValue is dust with regard to the given threshold.
+//
+// A value is dust when it is positive but strictly below the threshold;
+// zero and negative values are never dust.
+//
+// Parameters:
+// - threshold: the dust threshold, in the same coin as the CoinValue.
+//
+// Returns:
+// - bool: true if the CoinValue is dust, false otherwise.
+// - error: a *MismatchError, which wraps ErrCoinMismatch, if the threshold is for a different coin,
+// or an error wrapping ErrNilValue if it is nil.
+func (v CoinValue[D]) IsDust(threshold Value) (bool, error) {
+	if err := v.check("compare", threshold); err != nil {
+		return false, err
+	}
+
+	return v.Units().Sign() > 0 && v.Units().Cmp(threshold.Units()) < 0, nil
+}
+
+// Clamp returns the CoinValue bounded to the range [min, max].
+//
+// The result is always a new CoinValue, sharing no state with the CoinValue or the bounds.
+//
+// Parameters:
+// - min: the lower bound.
+// - max: the upper bound.
+//
+// Returns:
+// - Value: a copy of min if the CoinValue is below it, of max if it is above it, of the CoinValue otherwise.
+// - error: a *MismatchError if a bound is of a different coin, or an error if min is greater than max.
+func (v CoinValue[D]) Clamp(min, max Value) (Value, error) {
+	if err := v.check("compare", min); err != nil {
+		return nil, err
+	}
+	if err := v.check("compare", max); err != nil {
+		return nil, err
+	}
+	if min.Units().Cmp(max.Units()) > 0 {
+		return nil, fmt.Errorf("invalid range: %s is greater than %s", min.Coins(), max.Coins())
+	}
+
+	bounded := v.Units()
+	switch {
+	case bounded.Cmp(min.Units()) < 0:
+		bounded = min.Units()
+	case bounded.Cmp(max.Units()) > 0:
+		bounded = max.Units()
+	}
+	return v.derive(new(big.Int).Set(bounded)), nil
 }
 
 // Add adds the value of another CoinValue to the current CoinValue.
 //
 // It takes a Value as a parameter and returns a Value.
 // The function checks if the current CoinValue and the other Value have the same coin name.
-// If they don't, it panics with the message "cannot add values of different coins".
+// If they don't, it panics with a *MismatchError, and if other is nil with an error wrapping ErrNilValue.
 // If they are the same, it creates a new CoinValue with the same definition and adds the units of the other Value to the current CoinValue's value.
 // The function returns the new CoinValue.
 //
@@ -124,22 +715,21 @@ func (v CoinValue[D]) Same(other Value) bool {
 //
 // Returns:
 // - Value: the new CoinValue after the addition.
-func (v *CoinValue[D]) Add(other Value) Value {
-	if !v.Same(other) {
-		panic("cannot add values of different coins")
+func (v CoinValue[D]) Add(other Value) Value {
+	if err := v.check("add", other); err != nil {
+		panic(err)
 	}
 
-	return &CoinValue[D]{
-		def:   v.def,
-		value: new(big.Int).Add(v.value, other.Units()),
-	}
+	cv, units := v.deriveUnits()
+	units.Add(v.Units(), other.Units())
+	return cv
 }
 
 // Sub subtracts the value of another CoinValue from the current CoinValue.
 //
 // It takes a Value as a parameter and returns a Value.
 // The function checks if the current CoinValue and the other Value have the same coin name.
-// If they don't, it panics with the message "cannot subtract values of different coins".
+// If they don't, it panics with a *MismatchError, and if other is nil with an error wrapping ErrNilValue.
 // If they are the same, it creates a new CoinValue with the same definition and subtracts the units of the other Value from the current CoinValue's value.
 // The function returns the new CoinValue.
 //
@@ -148,22 +738,77 @@ func (v *CoinValue[D]) Add(other Value) Value {
 //
 // Returns:
 // - Value: the new CoinValue after the subtraction.
-func (v *CoinValue[D]) Sub(other Value) Value {
-	if !v.Same(other) {
-		panic("cannot subtract values of different coins")
+func (v CoinValue[D]) Sub(other Value) Value {
+	if err := v.check("subtract", other); err != nil {
+		panic(err)
 	}
 
-	return &CoinValue[D]{
-		def:   v.def,
-		value: new(big.Int).Sub(v.value, other.Units()),
+	cv, units := v.deriveUnits()
+	units.Sub(v.Units(), other.Units())
+	return cv
+}
+
+// AddUnits adds an amount of units to the CoinValue.
+//
+// It is a fast path for hot loops accumulating raw deltas: unlike Add, it doesn't require wrapping
+// the delta in a Value, which saves allocations, but it doesn't check the coin either.
+// The caller asserts that the delta is in the units of the coin of the CoinValue;
+// prefer Add whenever the delta comes from a Value.
+//
+// Parameters:
+// - delta: the amount of units to add, not retained.
+//
+// Returns:
+// - Value: the new CoinValue after the addition.
+func (v CoinValue[D]) AddUnits(delta *big.Int) Value {
+	cv, units := v.deriveUnits()
+	units.Add(v.Units(), delta)
+	return cv
+}
+
+// SubUnits subtracts an amount of units from the CoinValue.
+//
+// Like AddUnits, it is a fast path that doesn't check the coin, see AddUnits.
+//
+// Parameters:
+// - delta: the amount of units to subtract, not retained.
+//
+// Returns:
+// - Value: the new CoinValue after the subtraction.
+func (v CoinValue[D]) SubUnits(delta *big.Int) Value {
+	cv, units := v.deriveUnits()
+	units.Sub(v.Units(), delta)
+	return cv
+}
+
+// SubClamp subtracts the value of another CoinValue from the current CoinValue, flooring the result at zero.
+//
+// Unlike Sub, which allows negative results, SubClamp returns zero when the other Value is larger
+// than the current CoinValue, e.g. to display the funds available after reserving fees.
+//
+// Parameters:
+// - other: the Value to subtract.
+//
+// Returns:
+// - Value: the new CoinValue after the subtraction, never negative.
+// - error: a *MismatchError if the other Value is of a different coin.
+func (v CoinValue[D]) SubClamp(other Value) (Value, error) {
+	if err := v.check("subtract", other); err != nil {
+		return nil, err
 	}
+
+	value := new(big.Int).Sub(v.Units(), other.Units())
+	if value.Sign() < 0 {
+		value.SetInt64(0)
+	}
+	return v.derive(value), nil
 }
 
 // Mul multiplies the value of another CoinValue with the current CoinValue.
 //
 // It takes a Value as a parameter and returns a Value.
 // The function checks if the current CoinValue and the other Value have the same coin name.
-// If they don't, it panics with the message "cannot multiply values of different coins".
+// If they don't, it panics with a *MismatchError, and if other is nil with an error wrapping ErrNilValue.
 // If they are the same, it creates a new CoinValue with the same definition and multiplies the units of the other Value with the current CoinValue's value.
 // The function returns the new CoinValue.
 //
@@ -172,22 +817,21 @@ func (v *CoinValue[D]) Sub(other Value) Value {
 //
 // Returns:
 // - Value: the new CoinValue after the multiplication.
-func (v *CoinValue[D]) Mul(other Value) Value {
-	if !v.Same(other) {
-		panic("cannot multiply values of different coins")
+func (v CoinValue[D]) Mul(other Value) Value {
+	if err := v.check("multiply", other); err != nil {
+		panic(err)
 	}
 
-	return &CoinValue[D]{
-		def:   v.def,
-		value: new(big.Int).Mul(v.value, other.Units()),
-	}
+	cv, units := v.deriveUnits()
+	units.Mul(v.Units(), other.Units())
+	return cv
 }
 
 // Div divides the value of a CoinValue by another Value.
 //
 // It takes a Value as a parameter and returns a Value.
 // The function checks if the current CoinValue and the other Value have the same coin name.
-// If they don't, it panics with the message "cannot divide values of different coins".
+// If they don't, it panics with a *MismatchError, and if other is nil with an error wrapping ErrNilValue.
 // If they are the same, it creates a new CoinValue with the same definition and divides the units of the current CoinValue's value by the units of the other Value.
 // The function returns the new CoinValue.
 //
@@ -196,15 +840,52 @@ func (v *CoinValue[D]) Mul(other Value) Value {
 //
 // Returns:
 // - Value: the new CoinValue after the division.
-func (v *CoinValue[D]) Div(other Value) Value {
-	if !v.Same(other) {
-		panic("cannot divide values of different coins")
+func (v CoinValue[D]) Div(other Value) Value {
+	if err := v.check("divide", other); err != nil {
+		panic(err)
 	}
 
-	return &CoinValue[D]{
-		def:   v.def,
-		value: new(big.Int).Div(v.value, other.Units()),
+	cv, units := v.deriveUnits()
+	units.Div(v.Units(), other.Units())
+	return cv
+}
+
+// fractionPrecision is the number of decimal places of the ratio returned by FractionOf.
+const fractionPrecision = 18
+
+// FractionOf returns the ratio of the CoinValue to a total, e.g. 0.25 for 2.5 ETH of 10 ETH,
+// to display a holding as a share of a portfolio.
+//
+// Unlike Div, which returns a value in units, the ratio is a plain number,
+// rounded half away from zero to 18 decimal places.
+//
+// Parameters:
+// - total: the total, in the same coin as the CoinValue.
+//
+// Returns:
+// - decimal.Decimal: the ratio of the CoinValue to the total.
+// - error: a *MismatchError if the total is of a different coin, or an error if it is zero.
+func (v CoinValue[D]) FractionOf(total Value) (decimal.Decimal, error) {
+	if err := v.check("divide", total); err != nil {
+		return decimal.Zero, err
+	}
+	if total.Units().Sign() == 0 {
+		return decimal.Zero, errors.New("cannot compute the fraction of a zero total")
 	}
+
+	return decimal.NewFromBigInt(v.Units(), 0).DivRound(decimal.NewFromBigInt(total.Units(), 0), fractionPrecision), nil
+}
+
+// Abs returns the absolute value of the CoinValue.
+//
+// The function creates a new CoinValue with the same definition and the absolute units of the current CoinValue's value.
+//
+// Returns:
+// - Value: the new CoinValue holding the absolute value.
+func (v CoinValue[D]) Abs() Value {
+	cv, units := v.deriveUnits()
+	units.Abs(v.Units())
+	return cv
 }
 
 // MulScalar multiplies the value of a CoinValue by a scalar value.
@@ -218,11 +899,10 @@ func (v *CoinValue[D]) Div(other Value) Value {
 //
 // Returns:
 // - Value: the new CoinValue after the multiplication.
-func (v *CoinValue[D]) MulScalar(scalar *big.Int) Value {
-	return &CoinValue[D]{
-		def:   v.def,
-		value: new(big.Int).Mul(v.value, scalar),
-	}
+func (v CoinValue[D]) MulScalar(scalar *big.Int) Value {
+	cv, units := v.deriveUnits()
+	units.Mul(v.Units(), scalar)
+	return cv
 }
 
 // DivScalar divides the value of a CoinValue by a scalar value.
@@ -236,9 +916,123 @@ func (v *CoinValue[D]) MulScalar(scalar *big.Int) Value {
 //
 // Returns:
 // - Value: the new CoinValue after the division.
-func (v *CoinValue[D]) DivScalar(scalar *big.Int) Value {
-	return &CoinValue[D]{
-		def:   v.def,
-		value: new(big.Int).Div(v.value, scalar),
+func (v CoinValue[D]) DivScalar(scalar *big.Int) Value {
+	cv, units := v.deriveUnits()
+	units.Div(v.Units(), scalar)
+	return cv
+}
+
+// Pow raises the units of the CoinValue to the given power.
+//
+// Note that raising a coin amount to a power is rarely meaningful, and that the units get huge quickly;
+// to grow an amount by a rate over several periods, use Compound.
+//
+// Parameters:
+// - exp: the power to raise the units to.
+//
+// Returns:
+// - Value: the new CoinValue holding the units raised to the power.
+func (v CoinValue[D]) Pow(exp uint) Value {
+	return v.derive(new(big.Int).Exp(v.Units(), new(big.Int).SetUint64(uint64(exp)), nil))
+}
+
+// Compound returns the CoinValue compounded at the given rate over a number of periods,
+// i.e. the value multiplied by (1+ratePerPeriod)^periods.
+//
+// The computation is exact and the result is truncated toward zero to the unit.
+// The function panics with the message "cannot compound over a negative number of periods" if periods is negative.
+//
+// Parameters:
+// - ratePerPeriod: the rate applied every period, e.g. 0.1 for 10%.
+// - periods: the number of periods.
+//
+// Returns:
+// - Value: the new CoinValue after compounding.
+func (v CoinValue[D]) Compound(ratePerPeriod decimal.Decimal, periods int) Value {
+	if periods < 0 {
+		panic("cannot compound over a negative number of periods")
+	}
+
+	// exponentiation by squaring, exact as decimals multiply without rounding
+	factor := decimal.NewFromInt(1)
+	growth := ratePerPeriod.Add(factor)
+	for n := periods; n > 0; n >>= 1 {
+		if n&1 == 1 {
+			factor = factor.Mul(growth)
+		}
+		growth = growth.Mul(growth)
+	}
+	return v.derive(decimal.NewFromBigInt(v.Units(), 0).Mul(factor).BigInt())
+}
+
+// Accrue returns the CoinValue with the simple interest accrued at the given rate over a number of seconds,
+// i.e. the value plus value * ratePerSecond * seconds, unlike Compound which compounds the interest.
+//
+// The interest is computed exactly in units and rounded down to the unit.
+// The function panics with the message "cannot accrue over a negative number of seconds" if seconds is negative.
+//
+// Parameters:
+// - ratePerSecond: the rate applied every second, e.g. from APRToPerSecond.
+// - seconds: the number of seconds.
+//
+// Returns:
+// - Value: the new CoinValue with the accrued interest.
+func (v CoinValue[D]) Accrue(ratePerSecond decimal.Decimal, seconds int64) Value {
+	if seconds < 0 {
+		panic("cannot accrue over a negative number of seconds")
 	}
+
+	interest := decimal.NewFromBigInt(v.Units(), 0).Mul(ratePerSecond).Mul(decimal.NewFromInt(seconds)).Floor()
+	return v.derive(new(big.Int).Add(v.Units(), interest.BigInt()))
+}
+
+// WithSlippageDown returns the CoinValue reduced by the given slippage in basis points,
+// e.g. the minimum acceptable output of a swap.
+//
+// The result is rounded down, so the bound is never laxer than requested.
+// The function panics with an error wrapping ErrOutOfRange if bps isn't within [0, 10000].
+//
+// Parameters:
+// - bps: the slippage tolerance in basis points, 100 bps being 1%.
+//
+// Returns:
+// - Value: the new CoinValue reduced by the slippage.
+func (v CoinValue[D]) WithSlippageDown(bps int) Value {
+	checkBps(bps)
+	return v.derive(mulBpsFloor(v.Units(), int64(bpsDenominator-bps)))
+}
+
+// WithSlippageUp returns the CoinValue increased by the given slippage in basis points,
+// e.g. the maximum acceptable input of a swap.
+//
+// The result is rounded up, so the bound is never laxer than requested.
+// The function panics with an error wrapping ErrOutOfRange if bps isn't within [0, 10000].
+//
+// Parameters:
+// - bps: the slippage tolerance in basis points, 100 bps being 1%.
+//
+// Returns:
+// - Value: the new CoinValue increased by the slippage.
+func (v CoinValue[D]) WithSlippageUp(bps int) Value {
+	checkBps(bps)
+	value := new(big.Int).Neg(v.Units())
+	value = mulBpsFloor(value, int64(bpsDenominator+bps))
+	return v.derive(value.Neg(value))
+}
+
+const bpsDenominator = 10_000
+
+// checkBps panics if a slippage in basis points isn't within [0, 10000]: a negative slippage would
+// silently flip the direction of the bound, and reducing by more than 100% would make it negative.
+func checkBps(bps int) {
+	if bps < 0 || bps > bpsDenominator {
+		panic(fmt.Errorf("%w: slippage of %d bps is not within [0, %d]", ErrOutOfRange, bps, bpsDenominator))
+	}
+}
+
+// mulBpsFloor returns floor(x * bps / 10000).
+func mulBpsFloor(x *big.Int, bps int64) *big.Int {
+	value := new(big.Int).Mul(x, big.NewInt(bps))
+	// Div rounds toward negative infinity for a positive divisor
+	return value.Div(value, big.NewInt(bpsDenominator))
 }
@@ -0,0 +1,593 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+// testDefinition is the definition of the values of the tests, a coin with 18 decimals like Ether.
+type testDefinition struct{}
+
+func (testDefinition) CoinName() string { return "TST" }
+func (testDefinition) UnitExp() int32   { return 18 }
+
+// units returns a value of the test coin from an amount of units.
+func units(n int64) *CoinValue[testDefinition] {
+	return NewCoinValue[testDefinition](big.NewInt(n))
+}
+
+func TestMustParseCoinValue(t *testing.T) {
+	fixtures := []struct {
+		s     string
+		units string
+	}{
+		{"0", "0"},
+		{"1", "1000000000000000000"},
+		{"1.5", "1500000000000000000"},
+		{"-2.25", "-2250000000000000000"},
+		{"0.000000000000000001", "1"},
+		{"1e3", "1000000000000000000000"},
+	}
+	for _, f := range fixtures {
+		want, _ := new(big.Int).SetString(f.units, 10)
+		if got := MustParseCoinValue[testDefinition](f.s); got.Units().Cmp(want) != 0 {
+			t.Errorf("MustParseCoinValue(%q) = %s units, want %s", f.s, got.Units(), want)
+		}
+	}
+
+	for _, s := range []string{"", "abc", "1.5 TST", "1,5", "0.0000000000000000001"} {
+		t.Run(s, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("MustParseCoinValue(%q) didn't panic", s)
+				}
+			}()
+			MustParseCoinValue[testDefinition](s)
+		})
+	}
+}
+
+func FuzzParseCoinValue(f *testing.F) {
+	for _, s := range []string{
+		"0",
+		"1.5",
+		"-1.5",
+		"0.000000000000000001",
+		"123456789012345678901234567890.123456789012345678",
+		"-0.100000000000000000",
+		"1e-18",
+		"7.0",
+		"1e1000",
+		"1e100000",
+		"1e-1000000000",
+	} {
+		f.Add(s)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		v, err := ParseCoinValue[testDefinition](s)
+		if err != nil {
+			return
+		}
+
+		// the guarantee of NewCoinValueFromCoins: an amount with at most UnitExp decimals is kept as is
+		if want := decimal.RequireFromString(s); !v.Coins().Equal(want) {
+			t.Fatalf("ParseCoinValue(%q).Coins() = %s, want %s", s, v.Coins(), want)
+		}
+
+		amount, ok := strings.CutSuffix(v.String(), " TST")
+		if !ok {
+			t.Fatalf("ParseCoinValue(%q).String() = %q, want a TST suffix", s, v.String())
+		}
+		again, err := ParseCoinValue[testDefinition](amount)
+		if err != nil {
+			t.Fatalf("ParseCoinValue(%q) of the String() of %q: %v", amount, s, err)
+		}
+		if !again.Equals(v) {
+			t.Fatalf("round trip of %q through %q = %s units, want %s", s, amount, again.Units(), v.Units())
+		}
+	})
+}
+
+// benchValue is a value of 1234.567890123456789 coins, with enough digits for the conversions to matter.
+var benchValue = MustParseCoinValue[testDefinition]("1234.567890123456789")
+
+func BenchmarkCoinsMemoized(b *testing.B) {
+	v := NewCoinValue[testDefinition](benchValue.Units())
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = v.Coins()
+	}
+}
+
+func BenchmarkCoinsFirstCall(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		v := NewCoinValue[testDefinition](benchValue.Units())
+		b.StartTimer()
+		_ = v.Coins()
+	}
+}
+
+// deltas are the raw unit deltas accumulated by the AddUnits benchmarks.
+var deltas = func() []*big.Int {
+	d := make([]*big.Int, 1024)
+	for i := range d {
+		d[i] = big.NewInt(int64(i) * 1_000_000_007)
+	}
+	return d
+}()
+
+func BenchmarkAccumulateAdd(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var sum Value = units(0)
+		for _, delta := range deltas {
+			sum = sum.Add(NewCoinValue[testDefinition](delta))
+		}
+	}
+}
+
+func BenchmarkAccumulateAddUnits(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		sum := units(0)
+		for _, delta := range deltas {
+			sum = sum.AddUnits(delta).(*CoinValue[testDefinition])
+		}
+	}
+}
+
+// arithmeticAllocs is the allocation budget of the arithmetic methods documented on CoinValue:
+// the resulting CoinValue and the words of its units.
+const arithmeticAllocs = 2
+
+func TestArithmeticAllocs(t *testing.T) {
+	a, b := benchValue, MustParseCoinValue[testDefinition]("2.5")
+	scalar := big.NewInt(3)
+	tests := []struct {
+		name string
+		op   func()
+	}{
+		{"Add", func() { a.Add(b) }},
+		{"Sub", func() { a.Sub(b) }},
+		{"MulScalar", func() { a.MulScalar(scalar) }},
+	}
+	for _, tt := range tests {
+		if allocs := testing.AllocsPerRun(100, tt.op); allocs > arithmeticAllocs {
+			t.Errorf("%s makes %v allocations, want at most %d", tt.name, allocs, arithmeticAllocs)
+		}
+	}
+}
+
+func BenchmarkArithmetic(b *testing.B) {
+	a, other := benchValue, MustParseCoinValue[testDefinition]("2.5")
+	scalar := big.NewInt(3)
+	ops := []struct {
+		name string
+		op   func() Value
+	}{
+		{"Add", func() Value { return a.Add(other) }},
+		{"Sub", func() Value { return a.Sub(other) }},
+		{"Mul", func() Value { return a.Mul(other) }},
+		{"Div", func() Value { return a.Div(other) }},
+		{"MulScalar", func() Value { return a.MulScalar(scalar) }},
+	}
+	for _, op := range ops {
+		b.Run(op.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_ = op.op()
+			}
+		})
+	}
+}
+
+// BenchmarkConversions complements the benchmarks of Coins, BenchmarkCoinsMemoized and BenchmarkCoinsFirstCall.
+func BenchmarkConversions(b *testing.B) {
+	b.Run("ScaledValue", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = benchValue.ScaledValue(9)
+		}
+	})
+	b.Run("String", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = benchValue.String()
+		}
+	})
+}
+
+func TestNewNonNegativeCoinValue(t *testing.T) {
+	for _, n := range []*big.Int{nil, big.NewInt(0), big.NewInt(1), new(big.Int).Lsh(big.NewInt(1), 256)} {
+		got, err := NewNonNegativeCoinValue[testDefinition](n)
+		if err != nil {
+			t.Fatalf("NewNonNegativeCoinValue(%v) error = %v", n, err)
+		}
+		if want := NewCoinValue[testDefinition](n); !Equal(got, want) {
+			t.Errorf("NewNonNegativeCoinValue(%v) = %s units, want %s", n, got.Units(), want.Units())
+		}
+	}
+
+	for _, n := range []*big.Int{big.NewInt(-1), new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 256))} {
+		if got, err := NewNonNegativeCoinValue[testDefinition](n); !errors.Is(err, ErrOutOfRange) || got != nil {
+			t.Errorf("NewNonNegativeCoinValue(%s) = %v, %v, want nil, ErrOutOfRange", n, got, err)
+		}
+	}
+}
+
+func TestNewCoinValueFromScaled(t *testing.T) {
+	tests := []struct {
+		amount string
+		exp    int32
+		units  int64
+	}{
+		{"1", 0, 1},
+		{"1.5", 3, 1500},
+		{"2", 6, 2_000_000},
+		{"1.5", 9, 1_500_000_000},
+		{"-0.25", 18, -250_000_000_000_000_000},
+	}
+	for _, tt := range tests {
+		amount := decimal.RequireFromString(tt.amount)
+		v := NewCoinValueFromScaled[testDefinition](amount, tt.exp)
+		if v.Units().Int64() != tt.units {
+			t.Errorf("NewCoinValueFromScaled(%s, %d) = %s units, want %d", tt.amount, tt.exp, v.Units(), tt.units)
+		}
+		if got := v.ScaledValue(tt.exp); !got.Equal(amount) {
+			t.Errorf("ScaledValue(%d) of NewCoinValueFromScaled(%s, %d) = %s", tt.exp, tt.amount, tt.exp, got)
+		}
+		exact, err := NewCoinValueFromScaledChecked[testDefinition](amount, tt.exp)
+		if err != nil || !exact.Equals(v) {
+			t.Errorf("NewCoinValueFromScaledChecked(%s, %d) = %v, %v, want %d units", tt.amount, tt.exp, exact, err, tt.units)
+		}
+	}
+
+	// a tenth of a unit
+	if got := NewCoinValueFromScaled[testDefinition](decimal.RequireFromString("1.0001"), 3); got.Units().Int64() != 1000 {
+		t.Errorf("NewCoinValueFromScaled(1.0001, 3) = %s units, want the fraction truncated", got.Units())
+	}
+	if _, err := NewCoinValueFromScaledChecked[testDefinition](decimal.RequireFromString("1.0001"), 3); err == nil {
+		t.Errorf("NewCoinValueFromScaledChecked(1.0001, 3) succeeded, want an error")
+	}
+}
+
+// TestNewCoinValueFromScaledSemantics pins the breaking change of the meaning of exp,
+// now counted from the smallest unit rather than from whole coins.
+func TestNewCoinValueFromScaledSemantics(t *testing.T) {
+	one := decimal.NewFromInt(1)
+
+	// 1 thousand units, where exp 3 used to give 10^15 units
+	if got := NewCoinValueFromScaled[testDefinition](one, 3); got.Units().Int64() != 1000 {
+		t.Errorf("NewCoinValueFromScaled(1, 3) = %s units, want 1000", got.Units())
+	}
+	// the former result of exp 3, now obtained with UnitExp-3
+	if got := NewCoinValueFromScaled[testDefinition](one, 18-3); got.Units().Int64() != 1_000_000_000_000_000 {
+		t.Errorf("NewCoinValueFromScaled(1, 15) = %s units, want 10^15", got.Units())
+	}
+	// exp UnitExp is whole coins, like NewCoinValueFromCoins
+	if got := NewCoinValueFromScaled[testDefinition](one, 18); !got.Equals(NewCoinValueFromCoins[testDefinition](one)) {
+		t.Errorf("NewCoinValueFromScaled(1, 18) = %s units, want one coin", got.Units())
+	}
+}
+
+func TestIsDust(t *testing.T) {
+	threshold := units(546)
+	tests := []struct {
+		name  string
+		value *CoinValue[testDefinition]
+		want  bool
+	}{
+		{"equal to the threshold", units(546), false},
+		{"just below the threshold", units(545), true},
+		{"zero", units(0), false},
+		{"negative", units(-1), false},
+		{"above the threshold", units(547), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.value.IsDust(threshold)
+			if err != nil || got != tt.want {
+				t.Errorf("IsDust() of %s = %t, %v, want %t", tt.value.Units(), got, err, tt.want)
+			}
+		})
+	}
+
+	t.Run("coin mismatch", func(t *testing.T) {
+		_, err := units(1).IsDust(NewCoinValue[otherDefinition](big.NewInt(546)))
+		var mismatch *MismatchError
+		if !errors.As(err, &mismatch) {
+			t.Errorf("IsDust() error = %v, want a *MismatchError", err)
+		}
+	})
+}
+
+func TestComparable(t *testing.T) {
+	var v Value = units(2)
+	c, ok := v.(Comparable)
+	if !ok {
+		t.Fatal("CoinValue doesn't implement Comparable")
+	}
+	if c.Cmp(units(1)) != 1 || c.Cmp(units(2)) != 0 || c.Cmp(units(3)) != -1 {
+		t.Errorf("Cmp() doesn't order units")
+	}
+}
+
+func TestAppendJSON(t *testing.T) {
+	const digits = "115792089237316195423570985008687907853269984665640564039457584007913129639935"
+	max, _ := new(big.Int).SetString(digits, 10)
+
+	for _, tt := range []struct {
+		units *big.Int
+		want  string
+	}{
+		{max, digits},
+		{new(big.Int).Neg(max), "-" + digits},
+		{big.NewInt(0), "0"},
+	} {
+		got := NewCoinValue[testDefinition](tt.units).AppendJSON([]byte("units:"))
+		if string(got) != "units:"+tt.want {
+			t.Errorf("AppendJSON() = %s, want units:%s", got, tt.want)
+		}
+		if !json.Valid(got[len("units:"):]) {
+			t.Errorf("AppendJSON() = %s, not a valid JSON number", got)
+		}
+	}
+}
+
+func TestScaledUnits(t *testing.T) {
+	// 1.23456789 TST to 8 decimals, e.g. for a price feed
+	v := NewCoinValueFromCoins[testDefinition](decimal.RequireFromString("1.23456789"))
+	got, err := v.ScaledUnits(8)
+	if err != nil || got.Int64() != 123456789 {
+		t.Errorf("ScaledUnits(8) = %v, %v, want 123456789", got, err)
+	}
+	if got, err := v.ScaledUnits(20); err != nil || got.String() != "123456789000000000000" {
+		t.Errorf("ScaledUnits(20) = %v, %v, want 123456789000000000000", got, err)
+	}
+
+	// one more unit can't be expressed with 8 decimals
+	inexact := v.Add(units(1)).(*CoinValue[testDefinition])
+	if got, err := inexact.ScaledUnits(8); err == nil {
+		t.Errorf("ScaledUnits(8) of %s = %s, want an error", inexact.Coins(), got)
+	}
+	if got := inexact.ScaledUnitsTruncate(8); got.Int64() != 123456789 {
+		t.Errorf("ScaledUnitsTruncate(8) of %s = %s, want 123456789", inexact.Coins(), got)
+	}
+	if got := units(-1).ScaledUnitsTruncate(8); got.Sign() != 0 {
+		t.Errorf("ScaledUnitsTruncate(8) of -1 unit = %s, want 0", got)
+	}
+}
+
+func TestEquals(t *testing.T) {
+	oneCoin := NewCoinValueFromCoins[testDefinition](decimal.NewFromInt(1))
+	tests := []struct {
+		name string
+		a, b Value
+		want bool
+	}{
+		{"different constructors", oneCoin, NewCoinValue[testDefinition](big.NewInt(1e18)), true},
+		{"parsed", oneCoin, MustParseCoinValue[testDefinition]("1"), true},
+		{"different units", oneCoin, units(1), false},
+		{"different coins", oneCoin, NewCoinValue[otherDefinition](big.NewInt(1e18)), false},
+		{"different namespaces", oneCoin, NewCoinValue[layer2Definition](big.NewInt(1e18)), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Equal(tt.a, tt.b); got != tt.want {
+				t.Errorf("Equal(%s, %s) = %t, want %t", tt.a, tt.b, got, tt.want)
+			}
+			if got := Equal(tt.b, tt.a); got != tt.want {
+				t.Errorf("Equal(%s, %s) = %t, want %t", tt.b, tt.a, got, tt.want)
+			}
+			if got := oneCoin.Equals(tt.b); got != tt.want {
+				t.Errorf("Equals(%s) = %t, want %t", tt.b, got, tt.want)
+			}
+		})
+	}
+
+	var nilValue *CoinValue[testDefinition]
+	if Equal(oneCoin, nilValue) || Equal(nil, oneCoin) || oneCoin.Equals(nil) {
+		t.Errorf("a value is equal to nil")
+	}
+	if !Equal(nil, nilValue) {
+		t.Errorf("Equal(nil, nil pointer) = false, want true")
+	}
+}
+
+func TestSubClamp(t *testing.T) {
+	oneUnit, twoUnits := units(1), units(2)
+
+	got, err := oneUnit.SubClamp(twoUnits)
+	if err != nil || got.Units().Sign() != 0 {
+		t.Errorf("SubClamp() = %v, %v, want 0 units", got, err)
+	}
+	if got := oneUnit.Sub(twoUnits); got.Units().Int64() != -1 {
+		t.Errorf("Sub() = %s units, want -1", got.Units())
+	}
+	if got, err := twoUnits.SubClamp(oneUnit); err != nil || got.Units().Int64() != 1 {
+		t.Errorf("SubClamp() = %v, %v, want 1 unit", got, err)
+	}
+	if got, err := oneUnit.SubClamp(oneUnit); err != nil || got.Units().Sign() != 0 {
+		t.Errorf("SubClamp() of itself = %v, %v, want 0 units", got, err)
+	}
+
+	var mismatch *MismatchError
+	if _, err := oneUnit.SubClamp(NewCoinValue[otherDefinition](big.NewInt(2))); !errors.As(err, &mismatch) {
+		t.Errorf("SubClamp() error = %v, want a *MismatchError", err)
+	}
+}
+
+func TestCompound(t *testing.T) {
+	tests := []struct {
+		units   int64
+		rate    string
+		periods int
+		want    int64
+	}{
+		{100, "0.1", 2, 121},
+		{100, "0.1", 0, 100},
+		{100, "0.1", 1, 110},
+		// 133.1 truncated
+		{100, "0.1", 3, 133},
+		{100, "-0.5", 2, 25},
+	}
+	for _, tt := range tests {
+		got := units(tt.units).Compound(decimal.RequireFromString(tt.rate), tt.periods)
+		if got.Units().Int64() != tt.want {
+			t.Errorf("Compound(%s, %d) of %d units = %s, want %d", tt.rate, tt.periods, tt.units, got.Units(), tt.want)
+		}
+	}
+
+	if got := units(3).Pow(2); got.Units().Int64() != 9 {
+		t.Errorf("Pow(2) of 3 units = %s, want 9", got.Units())
+	}
+}
+
+func TestClamp(t *testing.T) {
+	low, high := units(10), units(20)
+	tests := []struct {
+		name  string
+		value *CoinValue[testDefinition]
+		want  int64
+	}{
+		{"below", units(5), 10},
+		{"within", units(15), 15},
+		{"above", units(25), 20},
+		{"at the floor", units(10), 10},
+		{"at the ceiling", units(20), 20},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.value.Clamp(low, high)
+			if err != nil || got.Units().Int64() != tt.want {
+				t.Fatalf("Clamp() of %s = %v, %v, want %d units", tt.value.Units(), got, err, tt.want)
+			}
+			// the result is a copy
+			before := tt.value.Units().Int64()
+			got.(*CoinValue[testDefinition]).AddInPlace(units(1))
+			if tt.value.Units().Int64() != before || low.Units().Int64() != 10 || high.Units().Int64() != 20 {
+				t.Errorf("modifying the result of Clamp() modified its operands")
+			}
+		})
+	}
+
+	if _, err := units(15).Clamp(high, low); err == nil {
+		t.Errorf("Clamp() with min > max succeeded, want an error")
+	}
+	var mismatch *MismatchError
+	if _, err := units(15).Clamp(low, NewCoinValue[otherDefinition](big.NewInt(20))); !errors.As(err, &mismatch) {
+		t.Errorf("Clamp() error = %v, want a *MismatchError", err)
+	}
+}
+
+func TestExactCoins(t *testing.T) {
+	tests := []struct {
+		units int64
+		want  string
+	}{
+		{1, "0.000000000000000001"},
+		{-1, "-0.000000000000000001"},
+		{1_500_000_000_000_000_000, "1.5"},
+		{0, "0"},
+	}
+	for _, tt := range tests {
+		got := units(tt.units).ExactCoins()
+		if got.String() != tt.want || !got.Equal(decimal.New(tt.units, -18)) {
+			t.Errorf("ExactCoins() of %d units = %s, want %s", tt.units, got, tt.want)
+		}
+	}
+
+	// the exponent is set directly, no division is involved
+	if exp := units(1).ExactCoins().Exponent(); exp != -18 {
+		t.Errorf("ExactCoins() exponent = %d, want -18", exp)
+	}
+}
+
+func TestFractionOf(t *testing.T) {
+	total := MustParseCoinValue[testDefinition]("10")
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{"2.5", "0.25"},
+		{"10", "1"},
+		{"0", "0"},
+		{"-5", "-0.5"},
+		{"20", "2"},
+		// rounded half away from zero to 18 places
+		{"3.333333333333333333", "0.333333333333333333"},
+		{"0.000000000000000005", "0.000000000000000001"},
+	}
+	for _, tt := range tests {
+		got, err := MustParseCoinValue[testDefinition](tt.value).FractionOf(total)
+		if err != nil || !got.Equal(decimal.RequireFromString(tt.want)) {
+			t.Errorf("FractionOf() of %s = %s, %v, want %s", tt.value, got, err, tt.want)
+		}
+	}
+
+	if _, err := total.FractionOf(units(0)); err == nil {
+		t.Errorf("FractionOf() of a zero total succeeded, want an error")
+	}
+	var mismatch *MismatchError
+	if _, err := total.FractionOf(NewCoinValue[otherDefinition](big.NewInt(1))); !errors.As(err, &mismatch) {
+		t.Errorf("FractionOf() error = %v, want a *MismatchError", err)
+	}
+}
+
+func TestCoinsWithPrecision(t *testing.T) {
+	tests := []struct {
+		value string
+		prec  int32
+		want  string
+	}{
+		{"1.5", 0, "2"},
+		{"-1.5", 0, "-2"},
+		{"1.49", 0, "1"},
+		{"1.23456789", 4, "1.2346"},
+		{"1.23456789", 18, "1.23456789"},
+		{"1.23456789", 30, "1.23456789"},
+		{"1234.5", -2, "1200"},
+		{"0.000000000000000001", 17, "0"},
+		{"0.000000000000000005", 17, "0.00000000000000001"},
+	}
+	for _, tt := range tests {
+		got := MustParseCoinValue[testDefinition](tt.value).CoinsWithPrecision(tt.prec)
+		if !got.Equal(decimal.RequireFromString(tt.want)) {
+			t.Errorf("CoinsWithPrecision(%d) of %s = %s, want %s", tt.prec, tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestFitsInUint256(t *testing.T) {
+	max := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+	tests := []struct {
+		name  string
+		units *big.Int
+		want  bool
+	}{
+		{"2^256-1", max, true},
+		{"2^256", new(big.Int).Add(max, big.NewInt(1)), false},
+		{"zero", big.NewInt(0), true},
+		{"minus one", big.NewInt(-1), false},
+		{"-(2^256-1)", new(big.Int).Neg(max), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := NewCoinValue[testDefinition](tt.units)
+			if got := v.FitsInUint256(); got != tt.want {
+				t.Errorf("FitsInUint256() = %t, want %t", got, tt.want)
+			}
+			if _, err := PackUint256(v); (err == nil) != tt.want {
+				t.Errorf("PackUint256() error = %v, inconsistent with FitsInUint256() = %t", err, tt.want)
+			}
+		})
+	}
+}
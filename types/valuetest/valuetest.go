@@ -0,0 +1,106 @@
+// Package valuetest provides helpers to test implementations of types.Value.
+package valuetest
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/airsigner/libcrypto/types"
+	"github.com/shopspring/decimal"
+)
+
+// AssertValue exercises the contract of types.Value against values built by ctor,
+// reporting any violation as a test error.
+//
+// It is meant for chain types embedding *types.CoinValue, to catch methods that
+// aren't promoted as expected, e.g. because of value and pointer receivers.
+//
+// Parameters:
+// - t: the test to report errors to.
+// - ctor: a constructor building a value from an amount of units.
+func AssertValue[T types.Value](t testing.TB, ctor func(units *big.Int) T) {
+	t.Helper()
+
+	a, b := ctor(big.NewInt(1200)), ctor(big.NewInt(300))
+
+	if a.CoinName() == "" {
+		t.Errorf("CoinName() is empty")
+	}
+	if got := a.Units(); got.Cmp(big.NewInt(1200)) != 0 {
+		t.Errorf("Units() = %s, want 1200", got)
+	}
+	if !a.Same(b) {
+		t.Errorf("Same() = false for two values of the same coin")
+	}
+	if types.Equal(a, b) || !types.Equal(a, ctor(big.NewInt(1200))) {
+		t.Errorf("Equal() doesn't compare units")
+	}
+	if c, ok := types.Value(a).(types.Comparable); ok {
+		if c.Cmp(b) != 1 || c.Cmp(ctor(big.NewInt(1200))) != 0 || c.Cmp(ctor(big.NewInt(1500))) != -1 {
+			t.Errorf("Cmp() doesn't order units")
+		}
+	}
+
+	checkUnits := func(op string, v types.Value, want int64) {
+		t.Helper()
+		if types.IsNil(v) {
+			t.Errorf("%s returned nil", op)
+			return
+		}
+		if !v.Same(a) {
+			t.Errorf("%s returned a value of coin %s, want %s", op, v.CoinName(), a.CoinName())
+		}
+		if v.Units().Cmp(big.NewInt(want)) != 0 {
+			t.Errorf("%s = %s units, want %d", op, v.Units(), want)
+		}
+	}
+	checkUnits("Add()", a.Add(b), 1500)
+	checkUnits("Sub()", a.Sub(b), 900)
+	checkUnits("Mul()", a.Mul(b), 360000)
+	checkUnits("Div()", a.Div(b), 4)
+	checkUnits("MulScalar()", a.MulScalar(big.NewInt(3)), 3600)
+	checkUnits("DivScalar()", a.DivScalar(big.NewInt(7)), 171)
+
+	if a.Units().Cmp(big.NewInt(1200)) != 0 || b.Units().Cmp(big.NewInt(300)) != 0 {
+		t.Errorf("arithmetic modified its operands")
+	}
+
+	if !a.ScaledValue(0).Equal(decimal.NewFromBigInt(a.Units(), 0)) {
+		t.Errorf("ScaledValue(0) = %s, want the units in decimal form", a.ScaledValue(0))
+	}
+}
+
+// RequireEqual checks that two values are of the same coin and hold the same units, see types.Equal,
+// failing the test immediately with a readable description of both values otherwise.
+// A nil Value, or a nil pointer wrapped in a Value, is only equal to another one, see types.IsNil.
+//
+// Parameters:
+// - t: the test to fail.
+// - expected: the expected value.
+// - actual: the actual value.
+func RequireEqual(t testing.TB, expected, actual types.Value) {
+	t.Helper()
+
+	if types.IsNil(expected) || types.IsNil(actual) {
+		if !types.IsNil(expected) || !types.IsNil(actual) {
+			t.Fatalf("values differ:\n  expected: %s\n  actual:   %s", describe(expected), describe(actual))
+		}
+		return
+	}
+	if !expected.Same(actual) {
+		t.Fatalf("values are of different coins:\n  expected: %s\n  actual:   %s", describe(expected), describe(actual))
+	}
+	if expected.Units().Cmp(actual.Units()) != 0 {
+		diff := actual.Sub(expected)
+		t.Fatalf("values differ:\n  expected: %s\n  actual:   %s\n  diff:     %s", describe(expected), describe(actual), describe(diff))
+	}
+}
+
+// describe returns the value in whole coins and in units, e.g. "1.5 ETH (1500000000000000000 units)".
+func describe(v types.Value) string {
+	if types.IsNil(v) {
+		return "nil"
+	}
+	return fmt.Sprintf("%s %s (%s units)", v.Coins(), v.CoinName(), v.Units())
+}
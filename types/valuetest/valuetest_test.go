@@ -0,0 +1,69 @@
+package valuetest
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/airsigner/libcrypto/types"
+)
+
+type testDefinition struct{}
+
+func (testDefinition) CoinName() string { return "TST" }
+func (testDefinition) UnitExp() int32   { return 2 }
+
+// recorder records the failures of the checks instead of failing the test.
+type recorder struct {
+	testing.TB
+	failures []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Fatalf(format string, args ...any) {
+	r.failures = append(r.failures, fmt.Sprintf(format, args...))
+}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.failures = append(r.failures, fmt.Sprintf(format, args...))
+}
+
+func TestAssertValue(t *testing.T) {
+	r := &recorder{TB: t}
+	AssertValue(r, types.NewCoinValue[testDefinition])
+	for _, f := range r.failures {
+		t.Error(f)
+	}
+}
+
+func TestRequireEqual(t *testing.T) {
+	var nilPointer *types.CoinValue[testDefinition]
+	one := types.NewCoinValue[testDefinition](big.NewInt(100))
+	tests := []struct {
+		name             string
+		expected, actual types.Value
+		equal            bool
+	}{
+		{"equal", one, types.NewCoinValue[testDefinition](big.NewInt(100)), true},
+		{"nil", nil, nil, true},
+		{"nil pointers", nilPointer, nilPointer, true},
+		{"nil and nil pointer", nil, nilPointer, true},
+		{"nil pointer and nil", nilPointer, nil, true},
+
+		{"different units", one, types.NewCoinValue[testDefinition](big.NewInt(101)), false},
+		{"nil expected", nil, one, false},
+		{"nil actual", one, nil, false},
+		{"nil pointer expected", nilPointer, one, false},
+		{"nil pointer actual", one, nilPointer, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &recorder{TB: t}
+			RequireEqual(r, tt.expected, tt.actual)
+			if failed := len(r.failures) > 0; failed == tt.equal {
+				t.Errorf("RequireEqual() failures = %q, want equal %v", r.failures, tt.equal)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+// Package vesting computes the amounts vested by token grants.
+package vesting
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/airsigner/libcrypto/types"
+)
+
+// Schedule is a linear vesting schedule with a cliff.
+//
+// The Total vests linearly from Start to End, but nothing is vested before the Cliff,
+// at which point everything accrued since Start is released at once.
+type Schedule struct {
+	Total types.Value
+	Start time.Time
+	Cliff time.Time
+	End   time.Time
+}
+
+// Vested returns the amount vested at the given time.
+//
+// The amount is computed on units with integer math and rounded down to the unit,
+// so it never exceeds the exact vested amount and is exactly Total from End on.
+//
+// Parameters:
+// - at: the time to compute the vested amount at.
+//
+// Returns:
+// - types.Value: the vested amount, zero before the Cliff and Total from End on.
+// - error: an error wrapping types.ErrNilValue if Total is nil, or an error if it is negative
+// or if the times aren't ordered as Start <= Cliff <= End.
+func (s Schedule) Vested(at time.Time) (types.Value, error) {
+	if err := s.validate(); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case at.Before(s.Cliff):
+		return s.Total.MulScalar(new(big.Int)), nil
+	case !at.Before(s.End):
+		return s.Total, nil
+	}
+
+	elapsed := big.NewInt(int64(at.Sub(s.Start)))
+	duration := big.NewInt(int64(s.End.Sub(s.Start)))
+	return s.Total.MulScalar(elapsed).DivScalar(duration), nil
+}
+
+func (s Schedule) validate() error {
+	if types.IsNil(s.Total) {
+		return fmt.Errorf("%w: vesting total", types.ErrNilValue)
+	}
+	if s.Total.Units().Sign() < 0 {
+		return errors.New("negative vesting total")
+	}
+	if s.Cliff.Before(s.Start) || s.End.Before(s.Cliff) {
+		return errors.New("vesting times must be ordered as start <= cliff <= end")
+	}
+	return nil
+}
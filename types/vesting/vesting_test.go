@@ -0,0 +1,75 @@
+package vesting
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/airsigner/libcrypto/types"
+)
+
+type testDefinition struct{}
+
+func (testDefinition) CoinName() string { return "TST" }
+func (testDefinition) UnitExp() int32   { return 18 }
+
+func TestVested(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	s := Schedule{
+		Total: types.NewCoinValue[testDefinition](big.NewInt(1200)),
+		Start: start,
+		Cliff: start.Add(90 * 24 * time.Hour),
+		End:   start.Add(360 * 24 * time.Hour),
+	}
+
+	tests := []struct {
+		name string
+		at   time.Time
+		want int64
+	}{
+		{"before the start", start.Add(-time.Hour), 0},
+		{"before the cliff", s.Cliff.Add(-time.Nanosecond), 0},
+		{"at the cliff", s.Cliff, 300},
+		{"mid-way", start.Add(180 * 24 * time.Hour), 600},
+		// 1200 * 181/360 = 603.33
+		{"rounded down", start.Add(181 * 24 * time.Hour), 603},
+		{"at the end", s.End, 1200},
+		{"after the end", s.End.Add(time.Hour), 1200},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.Vested(tt.at)
+			if err != nil || got.Units().Int64() != tt.want {
+				t.Errorf("Vested() = %v, %v, want %d units", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestVestedInvalid(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	total := types.NewCoinValue[testDefinition](big.NewInt(1200))
+	tests := []struct {
+		name     string
+		schedule Schedule
+	}{
+		{"nil total", Schedule{Start: start, Cliff: start, End: start}},
+		{"nil pointer total", Schedule{Total: (*types.CoinValue[testDefinition])(nil), Start: start, Cliff: start, End: start}},
+		{"negative total", Schedule{Total: types.NewCoinValue[testDefinition](big.NewInt(-1)), Start: start, Cliff: start, End: start}},
+		{"cliff before start", Schedule{Total: total, Start: start, Cliff: start.Add(-time.Hour), End: start}},
+		{"end before cliff", Schedule{Total: total, Start: start, Cliff: start.Add(time.Hour), End: start}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, err := tt.schedule.Vested(start); err == nil {
+				t.Errorf("Vested() = %s, want an error", got)
+			}
+		})
+	}
+
+	_, err := Schedule{Total: (*types.CoinValue[testDefinition])(nil)}.Vested(start)
+	if !errors.Is(err, types.ErrNilValue) {
+		t.Errorf("Vested() with a nil pointer total = %v, want ErrNilValue", err)
+	}
+}
@@ -0,0 +1,92 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Wallet holds subtotals of values of different coins, grouped by coin.
+//
+// Coins are identified by their qualified name, see Register, so that same-named coins of different namespaces,
+// e.g. mainnet and L2 ETH, have separate subtotals.
+//
+// The zero value is an empty Wallet ready to use.
+type Wallet struct {
+	balances map[string]Value
+}
+
+// Add adds a value to the subtotal of its coin.
+//
+// Parameters:
+// - value: the Value to add.
+//
+// Returns:
+// - error: an error wrapping ErrNilValue if the value is nil, in which case the Wallet is left unchanged.
+func (w *Wallet) Add(value Value) error {
+	if IsNil(value) {
+		return fmt.Errorf("%w: cannot add nil to a wallet", ErrNilValue)
+	}
+	if w.balances == nil {
+		w.balances = make(map[string]Value)
+	}
+
+	name := qualifiedName(value)
+	if balance, ok := w.balances[name]; ok {
+		w.balances[name] = balance.Add(value)
+	} else {
+		w.balances[name] = value
+	}
+	return nil
+}
+
+// Get returns the subtotal of the given coin.
+//
+// Parameters:
+// - coin: the qualified name of the coin, e.g. "ETH" or "ethereum-arbitrum/ETH".
+//
+// Returns:
+// - Value: the subtotal of the coin.
+// - bool: false if no value of the coin was added, true otherwise.
+func (w *Wallet) Get(coin string) (Value, bool) {
+	balance, ok := w.balances[coin]
+	return balance, ok
+}
+
+// Total returns the subtotals of all coins, keyed by qualified coin name.
+//
+// The returned map is a copy and can be modified freely.
+func (w *Wallet) Total() map[string]Value {
+	total := make(map[string]Value, len(w.balances))
+	for name, balance := range w.balances {
+		total[name] = balance
+	}
+	return total
+}
+
+// NonZero returns the subtotals of all coins that aren't zero, keyed by qualified coin name.
+func (w *Wallet) NonZero() map[string]Value {
+	nonZero := make(map[string]Value, len(w.balances))
+	for name, balance := range w.balances {
+		if balance.Units().Sign() != 0 {
+			nonZero[name] = balance
+		}
+	}
+	return nonZero
+}
+
+// String returns the subtotals of all coins ordered by qualified coin name, e.g. "0.5 BTC, 1.5 ETH".
+func (w *Wallet) String() string {
+	names := make([]string, 0, len(w.balances))
+	for name := range w.balances {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	subtotals := make([]string, len(names))
+	for i, name := range names {
+		balance := w.balances[name]
+		subtotals[i] = balance.Coins().String() + " " + name
+	}
+	return strings.Join(subtotals, ", ")
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+// layer2Definition is a coin named like testDefinition, in another namespace.
+type layer2Definition struct{ testDefinition }
+
+func (layer2Definition) Namespace() string { return "layer2" }
+
+func init() {
+	Register("layer2/TST", func(units *big.Int) Value {
+		return NewCoinValue[layer2Definition](units)
+	})
+}
+
+func TestWalletNamespaces(t *testing.T) {
+	var w Wallet
+	for _, v := range []Value{units(1), NewCoinValue[layer2Definition](big.NewInt(2)), units(3)} {
+		if err := w.Add(v); err != nil {
+			t.Fatalf("Add(%s) error = %v", v, err)
+		}
+	}
+
+	for name, want := range map[string]int64{"TST": 4, "layer2/TST": 2} {
+		got, ok := w.Get(name)
+		if !ok || got.Units().Int64() != want {
+			t.Errorf("Get(%q) = %v, %t, want %d units", name, got, ok, want)
+		}
+	}
+	if got, want := w.String(), "0.000000000000000004 TST, 0.000000000000000002 layer2/TST"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
+
+func TestWalletAddNil(t *testing.T) {
+	var w Wallet
+	if err := w.Add((*CoinValue[testDefinition])(nil)); !errors.Is(err, ErrNilValue) {
+		t.Errorf("Add(nil) error = %v, want ErrNilValue", err)
+	}
+	if len(w.Total()) != 0 {
+		t.Errorf("Total() = %v after failed Add, want empty", w.Total())
+	}
+}